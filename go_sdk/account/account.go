@@ -46,6 +46,11 @@ type AdvertiserInfo struct {
 	CreateTime            int64   `json:"create_time"`
 	Status                string  `json:"status"`
 	Balance               float64 `json:"balance"`
+
+	// Financial fields; the API may return these as numbers or numeric strings
+	SpendCap     tiktok.FlexFloat64 `json:"spend_cap"`
+	ValidAmount  tiktok.FlexFloat64 `json:"valid_amount"`
+	FrozenAmount tiktok.FlexFloat64 `json:"frozen_amount"`
 }
 
 // Balance represents balance information
@@ -60,9 +65,36 @@ type AdvertiserInfoResponse struct {
 	List []AdvertiserInfo `json:"list"`
 }
 
-// GetAdvertiserInfo gets advertiser information
+// DefaultAdvertiserFields returns the complete documented field list for GetAdvertiserInfo
+func DefaultAdvertiserFields() []string {
+	return []string{
+		"advertiser_id", "name", "address", "brand", "company", "contact_person",
+		"country", "currency", "description", "email", "industry", "language",
+		"license_no", "promotion_area", "promotion_center_city", "reason_for_advertising",
+		"telephone", "timezone", "display_timezone", "advertiser_account_type",
+		"balance_mode", "create_time", "status", "balance",
+		"spend_cap", "valid_amount", "frozen_amount",
+	}
+}
+
+// MaxAdvertiserInfoIDs is the maximum number of advertiser IDs per GetAdvertiserInfo call
+const MaxAdvertiserInfoIDs = 100
+
+// GetAdvertiserInfo gets advertiser information.
+// When fields is empty, DefaultAdvertiserFields is requested so that values such as
+// currency, balance and timezone are always populated.
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739593083610113
 func (a *API) GetAdvertiserInfo(ctx context.Context, advertiserIDs []string, fields []string) (*AdvertiserInfoResponse, error) {
+	if len(fields) == 0 {
+		fields = DefaultAdvertiserFields()
+	}
+
+	if len(advertiserIDs) == 0 {
+		if advertiserID, ok := tiktok.AdvertiserIDFromContext(ctx); ok {
+			advertiserIDs = []string{advertiserID}
+		}
+	}
+
 	params := url.Values{}
 
 	// Add advertiser_ids using helper
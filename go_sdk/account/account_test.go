@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,6 +18,7 @@ func TestGetAdvertiserInfo_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Contains(t, r.URL.Path, "/open_api/v1.3/advertiser/info/")
+		assert.Equal(t, []string{`["adv-123"]`}, r.URL.Query()["advertiser_ids"])
 
 		advertiserData := AdvertiserInfoResponse{
 			List: []AdvertiserInfo{
@@ -85,6 +88,60 @@ func TestGetAdvertiserInfo_WithFields(t *testing.T) {
 	assert.Len(t, result.List, 1)
 }
 
+func TestGetAdvertiserInfo_DefaultFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var fields []string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("fields")), &fields))
+		assert.Equal(t, DefaultAdvertiserFields(), fields)
+		assert.Contains(t, fields, "currency")
+		assert.Contains(t, fields, "balance")
+		assert.Contains(t, fields, "timezone")
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"advertiser_id":"adv-123","currency":"USD","balance":12.5,"timezone":"Etc/GMT"}]}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.GetAdvertiserInfo(context.Background(), []string{"adv-123"}, nil)
+	require.NoError(t, err)
+	require.Len(t, result.List, 1)
+	assert.Equal(t, "USD", result.List[0].Currency)
+	assert.Equal(t, 12.5, result.List[0].Balance)
+	assert.Equal(t, "Etc/GMT", result.List[0].Timezone)
+}
+
+func TestDefaultAdvertiserFields_MatchStruct(t *testing.T) {
+	typ := reflect.TypeOf(AdvertiserInfo{})
+	var tags []string
+	for i := 0; i < typ.NumField(); i++ {
+		tags = append(tags, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+	}
+	assert.ElementsMatch(t, tags, DefaultAdvertiserFields())
+}
+
+func TestAdvertiserInfo_FinancialFields(t *testing.T) {
+	payload := `{
+		"advertiser_id": "adv-123",
+		"currency": "USD",
+		"balance": 500,
+		"spend_cap": "1000.50",
+		"valid_amount": 450.25,
+		"frozen_amount": "49.75"
+	}`
+
+	var info AdvertiserInfo
+	require.NoError(t, json.Unmarshal([]byte(payload), &info))
+	assert.Equal(t, 1000.5, info.SpendCap.Float64())
+	assert.Equal(t, 450.25, info.ValidAmount.Float64())
+	assert.Equal(t, 49.75, info.FrozenAmount.Float64())
+}
+
 // Helper functions
 func ptrInt64(i int64) *int64 {
 	return &i
@@ -0,0 +1,100 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// Spend cap modes for SetSpendCap
+const (
+	SpendCapModeDaily     = "DAILY_BUDGET"
+	SpendCapModeMonthly   = "MONTHLY_BUDGET"
+	SpendCapModeCustom    = "CUSTOM_BUDGET"
+	SpendCapModeUnlimited = "UNLIMITED"
+)
+
+// validSpendCapModes lists the modes accepted by SetSpendCap
+var validSpendCapModes = map[string]bool{
+	SpendCapModeDaily:     true,
+	SpendCapModeMonthly:   true,
+	SpendCapModeCustom:    true,
+	SpendCapModeUnlimited: true,
+}
+
+// SpendCap is the spend cap of an advertiser account, in the account currency
+type SpendCap struct {
+	AdvertiserID string  `json:"advertiser_id"`
+	Amount       float64 `json:"amount"`
+	Currency     string  `json:"currency"`
+}
+
+// GetSpendCap gets the current spend cap of an advertiser account
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739593083610113
+func (a *API) GetSpendCap(ctx context.Context, advertiserID string) (*SpendCap, error) {
+	advertiserID = tiktok.ResolveAdvertiserID(ctx, advertiserID)
+	if advertiserID == "" {
+		return nil, errors.New("advertiser ID is required")
+	}
+
+	resp, err := a.GetAdvertiserInfo(ctx, []string{advertiserID}, []string{"advertiser_id", "spend_cap", "currency"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend cap: %w", err)
+	}
+
+	for _, info := range resp.List {
+		if info.AdvertiserID == advertiserID {
+			return &SpendCap{AdvertiserID: advertiserID, Amount: info.SpendCap.Float64(), Currency: info.Currency}, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to get spend cap: advertiser %s not found", advertiserID)
+}
+
+// advertiserBudget is an entry of advertiser_budgets in an advertiser update.
+// Unlike the top-level advertiser_id, its advertiser_id is an integer.
+type advertiserBudget struct {
+	AdvertiserID int64   `json:"advertiser_id"`
+	BudgetMode   string  `json:"budget_mode"`
+	Budget       float64 `json:"budget"`
+}
+
+// spendCapUpdateRequest is the advertiser update body for a spend cap change
+type spendCapUpdateRequest struct {
+	AdvertiserID      string             `json:"advertiser_id"`
+	AdvertiserBudgets []advertiserBudget `json:"advertiser_budgets"`
+}
+
+// SetSpendCap sets the spend cap of an advertiser account to amount, in the account
+// currency, for the period given by mode (SpendCapMode* values)
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739939050770434
+func (a *API) SetSpendCap(ctx context.Context, advertiserID string, amount float64, mode string) error {
+	advertiserID = tiktok.ResolveAdvertiserID(ctx, advertiserID)
+	if advertiserID == "" {
+		return errors.New("advertiser ID is required")
+	}
+	if amount < 0 {
+		return fmt.Errorf("spend cap must not be negative, got %v", amount)
+	}
+	if !validSpendCapModes[mode] {
+		return fmt.Errorf("invalid spend cap mode %q", mode)
+	}
+	budgetAdvertiserID, err := strconv.ParseInt(advertiserID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid advertiser ID %q: %w", advertiserID, err)
+	}
+
+	req := spendCapUpdateRequest{
+		AdvertiserID:      advertiserID,
+		AdvertiserBudgets: []advertiserBudget{{AdvertiserID: budgetAdvertiserID, BudgetMode: mode, Budget: amount}},
+	}
+
+	var resp struct{}
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/advertiser/update/", req, &resp); err != nil {
+		return fmt.Errorf("failed to set spend cap: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetSpendCap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/advertiser/info/", r.URL.Path)
+		assert.Equal(t, `["adv-123"]`, r.URL.Query().Get("advertiser_ids"))
+		assert.Equal(t, `["advertiser_id","spend_cap","currency"]`, r.URL.Query().Get("fields"))
+
+		response := tiktok.Response{
+			Code:    ptrInt64(0),
+			Message: ptrString("OK"),
+			Data:    json.RawMessage(`{"list":[{"advertiser_id":"adv-123","spend_cap":"500.25","currency":"USD"}]}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	spendCap, err := api.GetSpendCap(context.Background(), "adv-123")
+	require.NoError(t, err)
+	assert.Equal(t, &SpendCap{AdvertiserID: "adv-123", Amount: 500.25, Currency: "USD"}, spendCap)
+}
+
+func TestSetSpendCap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/advertiser/update/", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"advertiser_id": "7000000000123",
+			"advertiser_budgets": [{"advertiser_id": 7000000000123, "budget_mode": "DAILY_BUDGET", "budget": 1000.5}]
+		}`, string(body))
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Message: ptrString("OK")})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.SetSpendCap(context.Background(), "7000000000123", 1000.5, SpendCapModeDaily)
+	require.NoError(t, err)
+}
+
+func TestSetSpendCap_Validation(t *testing.T) {
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", "http://localhost", nil))
+
+	err := api.SetSpendCap(context.Background(), "adv-123", -1, SpendCapModeDaily)
+	assert.EqualError(t, err, "spend cap must not be negative, got -1")
+
+	err = api.SetSpendCap(context.Background(), "adv-123", 100, "WEEKLY")
+	assert.EqualError(t, err, `invalid spend cap mode "WEEKLY"`)
+
+	err = api.SetSpendCap(context.Background(), "", 100, SpendCapModeDaily)
+	assert.EqualError(t, err, "advertiser ID is required")
+
+	err = api.SetSpendCap(context.Background(), "adv-123", 100, SpendCapModeDaily)
+	assert.ErrorContains(t, err, `invalid advertiser ID "adv-123"`)
+}
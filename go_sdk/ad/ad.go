@@ -2,6 +2,7 @@ package ad
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -46,11 +47,17 @@ type GetAdResponse struct {
 
 // GetAdRequest represents the request to get ads
 type GetAdRequest struct {
+	tiktok.BaseListRequest
 	AdvertiserID string     `json:"advertiser_id"`
 	Filtering    *Filtering `json:"filtering,omitempty"`
-	Page         *int64     `json:"page,omitempty"`
-	PageSize     *int64     `json:"page_size,omitempty"`
-	Fields       []string   `json:"fields,omitempty"`
+	// ExcludeDeleted hides deleted ads by filtering on primary_status,
+	// unless Filtering already sets a primary status
+	ExcludeDeleted bool `json:"-"`
+	// ExcludeFieldTypesInResponse trims field groups from the response (e.g. tiktok.ExcludeFieldTypeCostData);
+	// excluded fields are left at their zero values
+	ExcludeFieldTypesInResponse []string `json:"exclude_field_types_in_response,omitempty"`
+	// CountOnly requests a single item and returns only PageInfo, leaving List empty
+	CountOnly bool `json:"-"`
 }
 
 // Filtering represents filtering options for ads
@@ -65,28 +72,49 @@ type Filtering struct {
 	CreateTimeMax   *string  `json:"create_time_max,omitempty"`
 }
 
+// filtering returns the filter to send, applying ExcludeDeleted without mutating the request
+func (r *GetAdRequest) filtering() *Filtering {
+	if !r.ExcludeDeleted || (r.Filtering != nil && r.Filtering.PrimaryStatus != nil) {
+		return r.Filtering
+	}
+
+	var f Filtering
+	if r.Filtering != nil {
+		f = *r.Filtering
+	}
+	status := tiktok.PrimaryStatusNotDelete
+	f.PrimaryStatus = &status
+	return &f
+}
+
 // GetAds gets ad information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1735735588640770
 func (a *API) GetAds(ctx context.Context, req *GetAdRequest) (*GetAdResponse, error) {
-	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
 
-	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	})
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	// Add fields using helper
-	if err := tiktok.AddStringSlice(params, "fields", req.Fields); err != nil {
+	// Add pagination, fields and sorting using helper
+	if err := req.Apply(a.client, params); err != nil {
 		return nil, err
 	}
 
 	// Add filtering using helper
-	if err := tiktok.AddJSONParam(params, "filtering", req.Filtering); err != nil {
+	if err := tiktok.AddJSONParam(params, "filtering", req.filtering()); err != nil {
+		return nil, err
+	}
+
+	if err := tiktok.AddStringSlice(params, "exclude_field_types_in_response", req.ExcludeFieldTypesInResponse); err != nil {
 		return nil, err
 	}
 
+	if req.CountOnly {
+		return a.countAds(ctx, params)
+	}
+
 	// Use generic DoGet helper
 	var resp GetAdResponse
 	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/ad/get/", params, &resp); err != nil {
@@ -96,6 +124,50 @@ func (a *API) GetAds(ctx context.Context, req *GetAdRequest) (*GetAdResponse, er
 	return &resp, nil
 }
 
+// Creative material modes
+const (
+	CreativeMaterialModeCustom  = "CUSTOM"
+	CreativeMaterialModeDynamic = "DYNAMIC"
+)
+
+// Ad formats
+const (
+	AdFormatSingleVideo = "SINGLE_VIDEO"
+	AdFormatSingleImage = "SINGLE_IMAGE"
+	// AdFormatSparkAd is the format of spark ads, which set TikTokItemID instead of VideoID
+	AdFormatSparkAd = AdFormatSingleVideo
+)
+
+// GetAllAds retrieves all ads by automatically handling pagination
+// This is a convenience method that calls GetAds multiple times if needed
+func (a *API) GetAllAds(ctx context.Context, req *GetAdRequest) ([]AdInfo, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	// Use maximum page size for efficiency
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]AdInfo, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
+
+		resp, err := a.GetAds(ctx, req)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to get ads page %d: %w", page, err)
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+}
+
+// GetAdsByCampaign retrieves all ads in a campaign, handling pagination
+func (a *API) GetAdsByCampaign(ctx context.Context, advertiserID, campaignID string) ([]AdInfo, error) {
+	return a.GetAllAds(ctx, &GetAdRequest{
+		AdvertiserID: advertiserID,
+		Filtering: &Filtering{
+			CampaignIDs: []string{campaignID},
+		},
+	})
+}
+
 // AdCreative represents a creative for an ad
 type AdCreative struct {
 	AdName         string   `json:"ad_name"`
@@ -103,22 +175,108 @@ type AdCreative struct {
 	AdFormat       string   `json:"ad_format"`
 	VideoID        *string  `json:"video_id,omitempty"`
 	ImageIDs       []string `json:"image_ids,omitempty"`
+	VideoIDs       []string `json:"video_ids,omitempty"` // DYNAMIC mode only
+	AdTexts        []string `json:"ad_texts,omitempty"`  // DYNAMIC mode only
 	CallToAction   *string  `json:"call_to_action,omitempty"`
 	DisplayName    *string  `json:"display_name,omitempty"`
 	LandingPageURL *string  `json:"landing_page_url,omitempty"`
 	IdentityID     *string  `json:"identity_id,omitempty"`
 	IdentityType   *string  `json:"identity_type,omitempty"`
+
+	// Spark ads promote an existing TikTok post instead of uploaded media
+	TikTokItemID *string `json:"tiktok_item_id,omitempty"`
+	AuthCode     *string `json:"auth_code,omitempty"`
+
+	// App promotion: deeplink opened in the installed app, and the instant page shown by the ad
+	Deeplink     *string `json:"deeplink,omitempty"`
+	DeeplinkType *string `json:"deeplink_type,omitempty"`
+	PageID       *string `json:"page_id,omitempty"`
+
+	// Third-party tracking URLs
+	ImpressionTrackingURL *string `json:"impression_tracking_url,omitempty"`
+	ClickTrackingURL      *string `json:"click_tracking_url,omitempty"`
+	VideoViewTrackingURL  *string `json:"video_view_tracking_url,omitempty"`
 }
 
 // CreateAdRequest represents a simplified request to create an ad
 // For full field list, see: https://business-api.tiktok.com/portal/docs?id=1737172488964097
 type CreateAdRequest struct {
-	AdvertiserID    string       `json:"advertiser_id"`
-	AdGroupID       string       `json:"adgroup_id"`
-	Creatives       []AdCreative `json:"creatives"`
-	OperationStatus *string      `json:"operation_status,omitempty"`
-	IdentityID      *string      `json:"identity_id,omitempty"`
-	IdentityType    *string      `json:"identity_type,omitempty"`
+	AdvertiserID         string       `json:"advertiser_id"`
+	AdGroupID            string       `json:"adgroup_id"`
+	CreativeMaterialMode *string      `json:"creative_material_mode,omitempty"`
+	Creatives            []AdCreative `json:"creatives"`
+	OperationStatus      *string      `json:"operation_status,omitempty"`
+	IdentityID           *string      `json:"identity_id,omitempty"`
+	IdentityType         *string      `json:"identity_type,omitempty"`
+}
+
+// Deeplink types
+const (
+	DeeplinkTypeNormal   = "NORMAL"
+	DeeplinkTypeDeferred = "DEFERRED_DEEPLINK"
+)
+
+// validate checks a single creative for combinations the API would reject
+func (c *AdCreative) validate() error {
+	if err := c.validateSparkAd(); err != nil {
+		return err
+	}
+	if c.Deeplink != nil && *c.Deeplink != "" && (c.DeeplinkType == nil || *c.DeeplinkType == "") {
+		return errors.New("deeplink_type is required when deeplink is set")
+	}
+	return nil
+}
+
+// IsSparkAd reports whether the creative promotes an existing TikTok post
+func (c *AdCreative) IsSparkAd() bool {
+	return c.TikTokItemID != nil || c.AuthCode != nil
+}
+
+// validateSparkAd checks that the spark ad fields of the creative are set together
+func (c *AdCreative) validateSparkAd() error {
+	if !c.IsSparkAd() {
+		return nil
+	}
+	if c.TikTokItemID == nil || *c.TikTokItemID == "" {
+		return errors.New("spark ad creative requires tiktok_item_id with auth_code")
+	}
+	if c.AuthCode == nil || *c.AuthCode == "" {
+		return errors.New("spark ad creative requires auth_code with tiktok_item_id")
+	}
+	if c.AdFormat != AdFormatSparkAd {
+		return fmt.Errorf("spark ad creative must use ad format %s, got %q", AdFormatSparkAd, c.AdFormat)
+	}
+	return nil
+}
+
+// Validate checks the request for combinations the API would reject
+func (r *CreateAdRequest) Validate() error {
+	for i := range r.Creatives {
+		if err := r.Creatives[i].validate(); err != nil {
+			return fmt.Errorf("creative %d: %w", i, err)
+		}
+	}
+
+	if r.CreativeMaterialMode != nil && *r.CreativeMaterialMode == CreativeMaterialModeDynamic {
+		var media, texts int
+		for _, c := range r.Creatives {
+			media += len(c.VideoIDs) + len(c.ImageIDs)
+			if c.VideoID != nil {
+				media++
+			}
+			texts += len(c.AdTexts)
+			if c.AdText != "" {
+				texts++
+			}
+		}
+		if media == 0 {
+			return errors.New("dynamic creative requires at least one video or image")
+		}
+		if texts == 0 {
+			return errors.New("dynamic creative requires at least one ad text")
+		}
+	}
+	return nil
 }
 
 // CreateAdResponse represents the response from creating an ad
@@ -129,11 +287,42 @@ type CreateAdResponse struct {
 // CreateAd creates a new ad with simplified parameters
 // Reference: https://business-api.tiktok.com/portal/docs?id=1737172488964097
 func (a *API) CreateAd(ctx context.Context, req *CreateAdRequest) (*CreateAdResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Use generic DoPost helper
 	var resp CreateAdResponse
 	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/ad/create/", req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create ad: %w", err)
 	}
 
+	a.client.Manifest().Record(tiktok.EntityTypeAd, req.AdvertiserID, resp.AdID)
+
 	return &resp, nil
 }
+
+// CreateAndGetAd creates an ad and fetches it, returning the full ad
+func (a *API) CreateAndGetAd(ctx context.Context, req *CreateAdRequest) (*AdInfo, error) {
+	created, err := a.CreateAd(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.GetAds(ctx, &GetAdRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering:    &Filtering{AdIDs: []string{created.AdID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ad %s was created but could not be fetched: %w", created.AdID, err)
+	}
+	if len(resp.List) == 0 {
+		return nil, fmt.Errorf("ad %s was created but not found", created.AdID)
+	}
+
+	return &resp.List[0], nil
+}
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+	"github.com/suthio/tiktok-business-api-sdk/go_sdk/creative"
 )
 
 func TestNewAPI(t *testing.T) {
@@ -123,9 +125,8 @@ func TestGetAds_WithPagination(t *testing.T) {
 	page := int64(2)
 	pageSize := int64(50)
 	result, err := api.GetAds(context.Background(), &GetAdRequest{
-		AdvertiserID: "123456789",
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	})
 
 	require.NoError(t, err)
@@ -169,8 +170,8 @@ func TestGetAds_WithFields(t *testing.T) {
 	api := NewAPI(client)
 
 	result, err := api.GetAds(context.Background(), &GetAdRequest{
-		AdvertiserID: "123456789",
-		Fields:       []string{"ad_id", "ad_name"},
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Fields: []string{"ad_id", "ad_name"}},
 	})
 
 	require.NoError(t, err)
@@ -365,6 +366,578 @@ func TestGetAds_WithImageAd(t *testing.T) {
 	assert.Len(t, result.List, 1)
 	assert.Len(t, result.List[0].ImageIDs, 3)
 	assert.Empty(t, result.List[0].VideoID)
+	assert.True(t, result.List[0].IsImageAd())
+}
+
+func TestCreateAd_DynamicCreative(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/ad/create/", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "DYNAMIC", body["creative_material_mode"])
+
+		creatives := body["creatives"].([]interface{})
+		require.Len(t, creatives, 1)
+		creative := creatives[0].(map[string]interface{})
+		assert.Equal(t, []interface{}{"video-1", "video-2"}, creative["video_ids"])
+		assert.Equal(t, []interface{}{"img-1"}, creative["image_ids"])
+		assert.Equal(t, []interface{}{"Text A", "Text B"}, creative["ad_texts"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"ad_id":"ad-dynamic-001"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	mode := CreativeMaterialModeDynamic
+	result, err := api.CreateAd(context.Background(), &CreateAdRequest{
+		AdvertiserID:         "123456789",
+		AdGroupID:            "adgroup-001",
+		CreativeMaterialMode: &mode,
+		Creatives: []AdCreative{
+			{
+				AdName:   "Dynamic Ad",
+				AdFormat: "SINGLE_VIDEO",
+				VideoIDs: []string{"video-1", "video-2"},
+				ImageIDs: []string{"img-1"},
+				AdTexts:  []string{"Text A", "Text B"},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ad-dynamic-001", result.AdID)
+}
+
+func TestCreateAdRequest_Validate(t *testing.T) {
+	mode := CreativeMaterialModeDynamic
+
+	t.Run("dynamic without media", func(t *testing.T) {
+		req := &CreateAdRequest{
+			CreativeMaterialMode: &mode,
+			Creatives:            []AdCreative{{AdTexts: []string{"Text A"}}},
+		}
+		assert.EqualError(t, req.Validate(), "dynamic creative requires at least one video or image")
+	})
+
+	t.Run("dynamic without text", func(t *testing.T) {
+		req := &CreateAdRequest{
+			CreativeMaterialMode: &mode,
+			Creatives:            []AdCreative{{VideoIDs: []string{"video-1"}}},
+		}
+		assert.EqualError(t, req.Validate(), "dynamic creative requires at least one ad text")
+	})
+
+	t.Run("custom mode is not checked", func(t *testing.T) {
+		custom := CreativeMaterialModeCustom
+		req := &CreateAdRequest{CreativeMaterialMode: &custom}
+		assert.NoError(t, req.Validate())
+	})
+}
+
+func TestCreateAd_TrackingURLsRoundTrip(t *testing.T) {
+	var created map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/ad/create/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			created = body["creatives"].([]interface{})[0].(map[string]interface{})
+
+			json.NewEncoder(w).Encode(tiktok.Response{
+				Code: ptrInt64(0),
+				Data: json.RawMessage(`{"ad_id":"ad-001"}`),
+			})
+		case "/open_api/v1.3/creative/get/":
+			data, _ := json.Marshal(map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"creative_id":             "creative-001",
+						"ad_id":                   "ad-001",
+						"advertiser_id":           "123456789",
+						"impression_tracking_url": created["impression_tracking_url"],
+						"click_tracking_url":      created["click_tracking_url"],
+						"video_view_tracking_url": created["video_view_tracking_url"],
+					},
+				},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 1, "total_page": 1},
+			})
+			json.NewEncoder(w).Encode(tiktok.Response{
+				Code: ptrInt64(0),
+				Data: json.RawMessage(data),
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+
+	_, err := NewAPI(client).CreateAd(context.Background(), &CreateAdRequest{
+		AdvertiserID: "123456789",
+		AdGroupID:    "adgroup-001",
+		Creatives: []AdCreative{
+			{
+				AdName:                "Tracked Ad",
+				AdText:                "Hello",
+				AdFormat:              "SINGLE_VIDEO",
+				VideoID:               ptrString("video-001"),
+				ImpressionTrackingURL: ptrString("https://track.example.com/imp"),
+				ClickTrackingURL:      ptrString("https://track.example.com/click"),
+				VideoViewTrackingURL:  ptrString("https://track.example.com/view"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://track.example.com/imp", created["impression_tracking_url"])
+	assert.Equal(t, "https://track.example.com/click", created["click_tracking_url"])
+	assert.Equal(t, "https://track.example.com/view", created["video_view_tracking_url"])
+
+	creatives, err := creative.NewAPI(client).GetCreatives(context.Background(), &creative.GetCreativesRequest{
+		AdvertiserID: "123456789",
+		Filtering:    &creative.Filtering{AdIDs: []string{"ad-001"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, creatives.List, 1)
+	assert.Equal(t, "https://track.example.com/imp", creatives.List[0].ImpressionTrackingURL)
+	assert.Equal(t, "https://track.example.com/click", creatives.List[0].ClickTrackingURL)
+	assert.Equal(t, "https://track.example.com/view", creatives.List[0].VideoViewTrackingURL)
+}
+
+func TestGetAds_ExcludeDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `{"adgroup_ids":["ag1"],"primary_status":"STATUS_NOT_DELETE"}`, r.URL.Query().Get("filtering"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetAds(context.Background(), &GetAdRequest{
+		AdvertiserID:   "123",
+		ExcludeDeleted: true,
+		Filtering:      &Filtering{AdgroupIDs: []string{"ag1"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetAdsByCampaign(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/open_api/v1.3/ad/get/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, `{"campaign_ids":["camp1"]}`, r.URL.Query().Get("filtering"))
+		assert.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"ad_id":"id1"},{"ad_id":"id2"}],"page_info":{"page":1,"page_size":100,"total_number":3,"total_page":2}}`
+		case "2":
+			data = `{"list":[{"ad_id":"id3"}],"page_info":{"page":2,"page_size":100,"total_number":3,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.GetAdsByCampaign(context.Background(), "123456", "camp1")
+	require.NoError(t, err)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, "id1", result[0].AdID)
+	assert.Equal(t, "id3", result[2].AdID)
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetAds_ExcludeFieldTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `["COST_DATA"]`, r.URL.Query().Get("exclude_field_types_in_response"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"ad_id":"ad1"}],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAds(context.Background(), &GetAdRequest{
+		AdvertiserID:                "123",
+		ExcludeFieldTypesInResponse: []string{tiktok.ExcludeFieldTypeCostData},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.List, 1)
+	assert.Equal(t, "ad1", resp.List[0].AdID)
+}
+
+func TestCountAds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/ad/get/", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "1", r.URL.Query().Get("page_size"))
+		assert.Equal(t, `{"campaign_ids":["c1"]}`, r.URL.Query().Get("filtering"))
+
+		// ad_id is a number here, so decoding the list into AdInfo would fail
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [{"ad_id": 12345}],
+				"page_info": {"page": 1, "page_size": 1, "total_number": 42, "total_page": 42}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	count, err := api.CountAds(context.Background(), "123", &Filtering{CampaignIDs: []string{"c1"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), count)
+}
+
+func TestGetAds_CountOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("page_size"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"ad_id":"ad1"}],"page_info":{"total_number":7}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAds(context.Background(), &GetAdRequest{
+		AdvertiserID:    "123",
+		BaseListRequest: tiktok.BaseListRequest{PageSize: ptrInt64(50)},
+		CountOnly:       true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.List)
+	assert.Equal(t, int64(7), resp.PageInfo.TotalNumber)
+}
+
+func TestGetAllAds_PageProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"ad_id":"ad1"},{"ad_id":"ad2"}],"page_info":{"page":1,"page_size":100,"total_number":3,"total_page":2}}`
+		case "2":
+			data = `{"list":[{"ad_id":"ad3"}],"page_info":{"page":2,"page_size":100,"total_number":3,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	var pages, totals []int64
+	var fetched []int
+	ctx := tiktok.WithPageProgress(context.Background(), func(page, totalPage int64, n int) {
+		pages = append(pages, page)
+		totals = append(totals, totalPage)
+		fetched = append(fetched, n)
+	})
+
+	ads, err := api.GetAllAds(ctx, &GetAdRequest{AdvertiserID: "123"})
+	require.NoError(t, err)
+	assert.Len(t, ads, 3)
+	assert.Equal(t, []int64{1, 2}, pages)
+	assert.Equal(t, []int64{2, 2}, totals)
+	assert.Equal(t, []int{2, 3}, fetched)
+}
+
+func TestGetAds_BaseListRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, url.Values{
+			"advertiser_id": {"123456789"},
+			"page":          {"2"},
+			"page_size":     {"50"},
+			"fields":        {`["ad_id","ad_name"]`},
+			"filtering":     {`{"campaign_ids":["c1"]}`},
+			"order_field":   {"create_time"},
+			"order_type":    {"ASC"},
+		}, query)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list": [], "page_info": {"page": 2, "page_size": 50, "total_number": 0, "total_page": 0}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	orderField := "create_time"
+	orderType := tiktok.OrderTypeAsc
+	_, err := api.GetAds(context.Background(), &GetAdRequest{
+		BaseListRequest: tiktok.BaseListRequest{
+			Page:       ptrInt64(2),
+			PageSize:   ptrInt64(50),
+			Fields:     []string{"ad_id", "ad_name"},
+			OrderField: &orderField,
+			OrderType:  &orderType,
+		},
+		AdvertiserID: "123456789",
+		Filtering:    &Filtering{CampaignIDs: []string{"c1"}},
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateAd_SparkAd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		created := body["creatives"].([]interface{})[0].(map[string]interface{})
+
+		assert.Equal(t, "7300000000000000001", created["tiktok_item_id"])
+		assert.Equal(t, "auth-code-123", created["auth_code"])
+		assert.Equal(t, "SINGLE_VIDEO", created["ad_format"])
+		assert.NotContains(t, created, "video_id")
+
+		json.NewEncoder(w).Encode(tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"ad_id":"ad-spark-001"}`),
+		})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.CreateAd(context.Background(), &CreateAdRequest{
+		AdvertiserID: "123456789",
+		AdGroupID:    "adgroup-001",
+		Creatives: []AdCreative{
+			{
+				AdName:       "Spark Ad",
+				AdFormat:     AdFormatSparkAd,
+				TikTokItemID: ptrString("7300000000000000001"),
+				AuthCode:     ptrString("auth-code-123"),
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ad-spark-001", result.AdID)
+}
+
+func TestCreateAdRequest_ValidateSparkAd(t *testing.T) {
+	t.Run("item without auth code", func(t *testing.T) {
+		req := &CreateAdRequest{Creatives: []AdCreative{{AdFormat: AdFormatSparkAd, TikTokItemID: ptrString("item-1")}}}
+		assert.EqualError(t, req.Validate(), "creative 0: spark ad creative requires auth_code with tiktok_item_id")
+	})
+
+	t.Run("auth code without item", func(t *testing.T) {
+		req := &CreateAdRequest{Creatives: []AdCreative{{AdFormat: AdFormatSparkAd, AuthCode: ptrString("code")}}}
+		assert.EqualError(t, req.Validate(), "creative 0: spark ad creative requires tiktok_item_id with auth_code")
+	})
+
+	t.Run("wrong ad format", func(t *testing.T) {
+		req := &CreateAdRequest{Creatives: []AdCreative{
+			{AdFormat: AdFormatSingleVideo, VideoID: ptrString("video-1")},
+			{AdFormat: AdFormatSingleImage, TikTokItemID: ptrString("item-1"), AuthCode: ptrString("code")},
+		}}
+		assert.EqualError(t, req.Validate(), `creative 1: spark ad creative must use ad format SINGLE_VIDEO, got "SINGLE_IMAGE"`)
+	})
+}
+
+func TestCreateAndGetAd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data string
+		switch r.URL.Path {
+		case "/open_api/v1.3/ad/create/":
+			assert.Equal(t, http.MethodPost, r.Method)
+			data = `{"ad_id": "ad-001"}`
+		case "/open_api/v1.3/ad/get/":
+			assert.Equal(t, `{"ad_ids":["ad-001"]}`, r.URL.Query().Get("filtering"))
+			data = `{
+				"list": [{"ad_id": "ad-001", "ad_name": "Created Ad", "adgroup_id": "adgroup-001", "video_id": "video-001", "operation_status": "ENABLE"}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	info, err := api.CreateAndGetAd(context.Background(), &CreateAdRequest{
+		AdvertiserID: "123456789",
+		AdGroupID:    "adgroup-001",
+		Creatives: []AdCreative{
+			{AdName: "Created Ad", AdFormat: AdFormatSingleVideo, VideoID: ptrString("video-001")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ad-001", info.AdID)
+	assert.Equal(t, "Created Ad", info.AdName)
+	assert.Equal(t, "video-001", info.VideoID)
+	assert.Equal(t, "ENABLE", info.OperationStatus)
+}
+
+func TestCreateAd_AppInstallCreative(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		created := body["creatives"].([]interface{})[0].(map[string]interface{})
+
+		assert.Equal(t, "myapp://product/42", created["deeplink"])
+		assert.Equal(t, "DEFERRED_DEEPLINK", created["deeplink_type"])
+		assert.Equal(t, "page-001", created["page_id"])
+		assert.Equal(t, "INSTALL_NOW", created["call_to_action"])
+
+		json.NewEncoder(w).Encode(tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"ad_id":"ad-app-001"}`),
+		})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.CreateAd(context.Background(), &CreateAdRequest{
+		AdvertiserID: "123456789",
+		AdGroupID:    "adgroup-001",
+		Creatives: []AdCreative{
+			{
+				AdName:       "App Install Ad",
+				AdText:       "Get the app",
+				AdFormat:     AdFormatSingleVideo,
+				VideoID:      ptrString("video-001"),
+				CallToAction: ptrString("INSTALL_NOW"),
+				Deeplink:     ptrString("myapp://product/42"),
+				DeeplinkType: ptrString(DeeplinkTypeDeferred),
+				PageID:       ptrString("page-001"),
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ad-app-001", result.AdID)
+}
+
+func TestCreateAdRequest_ValidateDeeplink(t *testing.T) {
+	req := &CreateAdRequest{Creatives: []AdCreative{
+		{AdFormat: AdFormatSingleVideo, Deeplink: ptrString("myapp://home")},
+	}}
+	assert.EqualError(t, req.Validate(), "creative 0: deeplink_type is required when deeplink is set")
+
+	req.Creatives[0].DeeplinkType = ptrString(DeeplinkTypeNormal)
+	assert.NoError(t, req.Validate())
+}
+
+func TestCreateAd_RecordsManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{"ad_id": "ad-001"}`)})
+	}))
+	defer server.Close()
+
+	manifest := tiktok.NewManifest()
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil, tiktok.WithManifest(manifest))
+	api := NewAPI(client)
+
+	_, err := api.CreateAd(context.Background(), &CreateAdRequest{
+		AdvertiserID: "123456789",
+		AdGroupID:    "adgroup-001",
+		Creatives: []AdCreative{
+			{AdName: "Created Ad", AdFormat: AdFormatSingleVideo, VideoID: ptrString("video-001")},
+		},
+	})
+	require.NoError(t, err)
+
+	entries := manifest.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, tiktok.EntityTypeAd, entries[0].Type)
+	assert.Equal(t, "ad-001", entries[0].ID)
+	assert.Equal(t, "123456789", entries[0].AdvertiserID)
+}
+
+func TestGetAllAdsStable(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "create_time", r.URL.Query().Get("order_field"))
+		assert.Equal(t, "ASC", r.URL.Query().Get("order_type"))
+
+		// ad2 shifts onto page 2 after an earlier ad is deleted between requests
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"ad_id":"ad1"},{"ad_id":"ad2"}],"page_info":{"page":1,"page_size":100,"total_number":4,"total_page":2}}`
+		case "2":
+			data = `{"list":[{"ad_id":"ad2"},{"ad_id":"ad3"},{"ad_id":"ad4"}],"page_info":{"page":2,"page_size":100,"total_number":4,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.GetAllAdsStable(context.Background(), "123456")
+	require.NoError(t, err)
+
+	ids := make([]string, len(result))
+	for i, ad := range result {
+		ids[i] = ad.AdID
+	}
+	assert.Equal(t, []string{"ad1", "ad2", "ad3", "ad4"}, ids)
+	assert.Equal(t, 2, calls)
 }
 
 // Helper functions
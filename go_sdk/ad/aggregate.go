@@ -0,0 +1,19 @@
+package ad
+
+// CountByStatus counts ads by operation status
+func CountByStatus(ads []AdInfo) map[string]int {
+	counts := make(map[string]int)
+	for _, a := range ads {
+		counts[a.OperationStatus]++
+	}
+	return counts
+}
+
+// CountByPrimaryStatus counts ads by primary status
+func CountByPrimaryStatus(ads []AdInfo) map[string]int {
+	counts := make(map[string]int)
+	for _, a := range ads {
+		counts[a.PrimaryStatus]++
+	}
+	return counts
+}
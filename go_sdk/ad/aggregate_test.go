@@ -0,0 +1,23 @@
+package ad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountByStatus(t *testing.T) {
+	ads := []AdInfo{
+		{AdID: "ad-1", OperationStatus: "ENABLE", PrimaryStatus: "STATUS_DELIVERY_OK"},
+		{AdID: "ad-2", OperationStatus: "ENABLE", PrimaryStatus: "STATUS_NOT_DELIVERY"},
+		{AdID: "ad-3", OperationStatus: "DISABLE", PrimaryStatus: "STATUS_NOT_DELIVERY"},
+	}
+
+	counts := CountByStatus(ads)
+	assert.Equal(t, 2, counts["ENABLE"])
+	assert.Equal(t, 1, counts["DISABLE"])
+
+	primary := CountByPrimaryStatus(ads)
+	assert.Equal(t, 1, primary["STATUS_DELIVERY_OK"])
+	assert.Equal(t, 2, primary["STATUS_NOT_DELIVERY"])
+}
@@ -0,0 +1,40 @@
+package ad
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// countResponse decodes only the page info of a get response
+type countResponse struct {
+	PageInfo tiktok.PageInfo `json:"page_info"`
+}
+
+// countAds fetches a single ad and returns only the page info
+func (a *API) countAds(ctx context.Context, params url.Values) (*GetAdResponse, error) {
+	params.Set("page", "1")
+	params.Set("page_size", "1")
+
+	var resp countResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/ad/get/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to count ads: %w", err)
+	}
+
+	return &GetAdResponse{PageInfo: resp.PageInfo}, nil
+}
+
+// CountAds returns the number of ads matching filtering without fetching them
+func (a *API) CountAds(ctx context.Context, advertiserID string, filtering *Filtering) (int64, error) {
+	resp, err := a.GetAds(ctx, &GetAdRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    filtering,
+		CountOnly:    true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.PageInfo.TotalNumber, nil
+}
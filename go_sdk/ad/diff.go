@@ -0,0 +1,59 @@
+package ad
+
+import tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+
+// AdChange describes an ad present in both snapshots whose fields changed
+type AdChange struct {
+	AdID   string
+	Old    AdInfo
+	New    AdInfo
+	Deltas []tiktok.FieldDelta
+}
+
+// AdDiff is the result of comparing two ad snapshots
+type AdDiff struct {
+	Added   []AdInfo
+	Removed []AdInfo
+	Changed []AdChange
+}
+
+// IsEmpty reports whether the snapshots are identical
+func (d *AdDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares two ad snapshots by ad ID.
+// Added and Changed follow the order of newAds; Removed follows oldAds.
+func Diff(oldAds, newAds []AdInfo) *AdDiff {
+	previous := make(map[string]AdInfo, len(oldAds))
+	for _, a := range oldAds {
+		previous[a.AdID] = a
+	}
+
+	diff := &AdDiff{}
+	seen := make(map[string]bool, len(newAds))
+	for _, a := range newAds {
+		seen[a.AdID] = true
+		old, ok := previous[a.AdID]
+		if !ok {
+			diff.Added = append(diff.Added, a)
+			continue
+		}
+		if deltas := tiktok.DiffFields(old, a); len(deltas) > 0 {
+			diff.Changed = append(diff.Changed, AdChange{
+				AdID:   a.AdID,
+				Old:    old,
+				New:    a,
+				Deltas: deltas,
+			})
+		}
+	}
+
+	for _, a := range oldAds {
+		if !seen[a.AdID] {
+			diff.Removed = append(diff.Removed, a)
+		}
+	}
+
+	return diff
+}
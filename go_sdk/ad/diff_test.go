@@ -0,0 +1,31 @@
+package ad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestDiff(t *testing.T) {
+	before := []AdInfo{
+		{AdID: "a1", OperationStatus: "ENABLE", ImageIDs: []string{"img1"}},
+		{AdID: "a2", OperationStatus: "ENABLE"},
+	}
+	after := []AdInfo{
+		{AdID: "a1", OperationStatus: "DISABLE", ImageIDs: []string{"img1", "img2"}},
+	}
+
+	diff := Diff(before, after)
+
+	assert.Empty(t, diff.Added)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "a2", diff.Removed[0].AdID)
+
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, []tiktok.FieldDelta{
+		{Field: "image_ids", Old: []string{"img1"}, New: []string{"img1", "img2"}},
+		{Field: "operation_status", Old: "ENABLE", New: "DISABLE"},
+	}, diff.Changed[0].Deltas)
+}
@@ -0,0 +1,31 @@
+package ad
+
+// Ad media types returned by AdInfo.MediaType
+const (
+	MediaTypeVideo   = "VIDEO"
+	MediaTypeImage   = "IMAGE"
+	MediaTypeUnknown = "UNKNOWN"
+)
+
+// MediaType returns whether the ad is a video or an image ad.
+// Video ads can also carry a cover image in ImageIDs, so VideoID takes precedence.
+func (a *AdInfo) MediaType() string {
+	switch {
+	case a.VideoID != "":
+		return MediaTypeVideo
+	case len(a.ImageIDs) > 0:
+		return MediaTypeImage
+	default:
+		return MediaTypeUnknown
+	}
+}
+
+// IsVideoAd reports whether the ad is a video ad
+func (a *AdInfo) IsVideoAd() bool {
+	return a.MediaType() == MediaTypeVideo
+}
+
+// IsImageAd reports whether the ad is an image ad
+func (a *AdInfo) IsImageAd() bool {
+	return a.MediaType() == MediaTypeImage
+}
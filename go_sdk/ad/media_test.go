@@ -0,0 +1,30 @@
+package ad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdInfo_MediaType(t *testing.T) {
+	tests := []struct {
+		name      string
+		ad        AdInfo
+		mediaType string
+		isVideo   bool
+		isImage   bool
+	}{
+		{"video only", AdInfo{VideoID: "video-001"}, MediaTypeVideo, true, false},
+		{"video with cover image", AdInfo{VideoID: "video-001", ImageIDs: []string{"cover-001"}}, MediaTypeVideo, true, false},
+		{"image only", AdInfo{ImageIDs: []string{"img-001", "img-002", "img-003"}}, MediaTypeImage, false, true},
+		{"neither", AdInfo{}, MediaTypeUnknown, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.mediaType, tt.ad.MediaType())
+			assert.Equal(t, tt.isVideo, tt.ad.IsVideoAd())
+			assert.Equal(t, tt.isImage, tt.ad.IsImageAd())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package ad
+
+import (
+	"context"
+	"fmt"
+)
+
+// Ad secondary statuses for review states
+const (
+	// SecondaryStatusAudit means the ad is under review
+	SecondaryStatusAudit = "AD_STATUS_AUDIT"
+	// SecondaryStatusReaudit means the edited ad is under review again
+	SecondaryStatusReaudit = "AD_STATUS_REAUDIT"
+	// SecondaryStatusReject means the ad was rejected and needs to be fixed
+	SecondaryStatusReject = "AD_STATUS_AUDIT_DENY"
+	// SecondaryStatusDeliveryOK means the ad passed review and is delivering
+	SecondaryStatusDeliveryOK = "AD_STATUS_DELIVERY_OK"
+)
+
+// IsRejected reports whether the ad was rejected in review
+func (a *AdInfo) IsRejected() bool {
+	return a.SecondaryStatus == SecondaryStatusReject
+}
+
+// IsUnderReview reports whether the ad is waiting for review
+func (a *AdInfo) IsUnderReview() bool {
+	return a.SecondaryStatus == SecondaryStatusAudit || a.SecondaryStatus == SecondaryStatusReaudit
+}
+
+// GetRejectedAds gets all ads that were rejected in review.
+// The status filter is applied on the server and re-checked locally.
+func (a *API) GetRejectedAds(ctx context.Context, advertiserID string) ([]AdInfo, error) {
+	secondaryStatus := SecondaryStatusReject
+
+	ads, err := a.GetAllAds(ctx, &GetAdRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    &Filtering{SecondaryStatus: &secondaryStatus},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rejected ads: %w", err)
+	}
+
+	var rejected []AdInfo
+	for _, ad := range ads {
+		if ad.IsRejected() {
+			rejected = append(rejected, ad)
+		}
+	}
+
+	return rejected, nil
+}
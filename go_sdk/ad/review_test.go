@@ -0,0 +1,105 @@
+package ad
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetRejectedAds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/ad/get/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.JSONEq(t, `{"secondary_status":"AD_STATUS_AUDIT_DENY"}`, r.URL.Query().Get("filtering"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [
+					{"ad_id": "ad1", "secondary_status": "AD_STATUS_AUDIT_DENY"},
+					{"ad_id": "ad2", "secondary_status": "AD_STATUS_DELIVERY_OK"}
+				],
+				"page_info": {"page": 1, "page_size": 100, "total_number": 2, "total_page": 1}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	ads, err := api.GetRejectedAds(context.Background(), "123456")
+	require.NoError(t, err)
+
+	require.Len(t, ads, 1)
+	assert.Equal(t, "ad1", ads[0].AdID)
+}
+
+func TestAdInfo_ReviewStatus(t *testing.T) {
+	assert.True(t, (&AdInfo{SecondaryStatus: SecondaryStatusReject}).IsRejected())
+	assert.True(t, (&AdInfo{SecondaryStatus: SecondaryStatusAudit}).IsUnderReview())
+	assert.True(t, (&AdInfo{SecondaryStatus: SecondaryStatusReaudit}).IsUnderReview())
+	assert.False(t, (&AdInfo{SecondaryStatus: SecondaryStatusDeliveryOK}).IsRejected())
+	assert.False(t, (&AdInfo{SecondaryStatus: SecondaryStatusDeliveryOK}).IsUnderReview())
+}
+
+func TestGetAdReviewInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/ad/review_info/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, `["ad1","ad2"]`, r.URL.Query().Get("ad_ids"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"ad_review_map": {
+					"ad1": {
+						"ad_id": "ad1",
+						"is_approved": false,
+						"review_status": "REJECT",
+						"reject_info": [
+							{"reasons": ["Misleading claims", "Prohibited product"], "suggestion": "Remove the health claims"},
+							{"reasons": ["Low quality video"]}
+						]
+					},
+					"ad2": {"ad_id": "ad2", "is_approved": true, "review_status": "ALL_AVAILABLE"}
+				}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAdReviewInfo(context.Background(), "123456", []string{"ad1", "ad2"})
+	require.NoError(t, err)
+	require.Len(t, resp.AdReviewMap, 2)
+
+	rejected := resp.AdReviewMap["ad1"]
+	assert.False(t, rejected.IsApproved)
+	assert.Equal(t, []string{"Misleading claims", "Prohibited product", "Low quality video"}, rejected.RejectReasons())
+	assert.Equal(t, "Remove the health claims", rejected.RejectInfo[0].Suggestion)
+
+	approved := resp.AdReviewMap["ad2"]
+	assert.True(t, approved.IsApproved)
+	assert.Empty(t, approved.RejectReasons())
+}
+
+func TestGetAdReviewInfo_InvalidAdIDs(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.GetAdReviewInfo(context.Background(), "123456", nil)
+	assert.Error(t, err)
+
+	_, err = api.GetAdReviewInfo(context.Background(), "123456", make([]string, 101))
+	assert.Error(t, err)
+}
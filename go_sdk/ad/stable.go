@@ -0,0 +1,42 @@
+package ad
+
+import (
+	"context"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// stableOrderField is the sort field that keeps ad pages stable while ads change
+const stableOrderField = "create_time"
+
+// GetAllAdsStable gets all ads of an advertiser as a consistent snapshot.
+// Pages are sorted by create_time ascending so that new ads land on the last page,
+// and ads repeated across pages (e.g. after an earlier ad was deleted mid-pagination)
+// are returned once, in the order first seen.
+func (a *API) GetAllAdsStable(ctx context.Context, advertiserID string) ([]AdInfo, error) {
+	orderField := stableOrderField
+	orderType := tiktok.OrderTypeAsc
+
+	ads, err := a.GetAllAds(ctx, &GetAdRequest{
+		BaseListRequest: tiktok.BaseListRequest{
+			OrderField: &orderField,
+			OrderType:  &orderType,
+		},
+		AdvertiserID: advertiserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unique := make([]AdInfo, 0, len(ads))
+	seen := make(map[string]bool, len(ads))
+	for _, ad := range ads {
+		if seen[ad.AdID] {
+			continue
+		}
+		seen[ad.AdID] = true
+		unique = append(unique, ad)
+	}
+
+	return unique, nil
+}
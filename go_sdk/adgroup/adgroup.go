@@ -2,6 +2,7 @@ package adgroup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -22,27 +23,35 @@ func NewAPI(client *tiktok.Client) *API {
 
 // AdGroupInfo represents ad group information
 type AdGroupInfo struct {
-	AdgroupID         string   `json:"adgroup_id"`
-	AdgroupName       string   `json:"adgroup_name"`
-	CampaignID        string   `json:"campaign_id"`
-	AdvertiserID      string   `json:"advertiser_id"`
-	ObjectiveType     string   `json:"objective_type,omitempty"`
-	Budget            float64  `json:"budget,omitempty"`
-	BudgetMode        string   `json:"budget_mode,omitempty"`
-	BillingEvent      string   `json:"billing_event,omitempty"`
-	OptimizationGoal  string   `json:"optimization_goal,omitempty"`
-	Placements        []string `json:"placements,omitempty"`
-	Locations         []string `json:"locations,omitempty"`
-	Age               []string `json:"age,omitempty"`
-	Gender            string   `json:"gender,omitempty"`
-	Languages         []string `json:"languages,omitempty"`
-	OperationStatus   string   `json:"operation_status"`
-	PrimaryStatus     string   `json:"primary_status,omitempty"`
-	SecondaryStatus   string   `json:"secondary_status,omitempty"`
-	CreateTime        string   `json:"create_time"`
-	ModifyTime        string   `json:"modify_time"`
-	ScheduleStartTime string   `json:"schedule_start_time,omitempty"`
-	ScheduleEndTime   string   `json:"schedule_end_time,omitempty"`
+	AdgroupID        string   `json:"adgroup_id"`
+	AdgroupName      string   `json:"adgroup_name"`
+	CampaignID       string   `json:"campaign_id"`
+	AdvertiserID     string   `json:"advertiser_id"`
+	ObjectiveType    string   `json:"objective_type,omitempty"`
+	Budget           float64  `json:"budget,omitempty"`
+	BudgetMode       string   `json:"budget_mode,omitempty"`
+	BillingEvent     string   `json:"billing_event,omitempty"`
+	OptimizationGoal string   `json:"optimization_goal,omitempty"`
+	Placements       []string `json:"placements,omitempty"`
+	Locations        []string `json:"locations,omitempty"`
+	Age              []string `json:"age,omitempty"`
+	Gender           string   `json:"gender,omitempty"`
+	Languages        []string `json:"languages,omitempty"`
+	// AudienceIDs and ExcludedAudienceIDs are the custom audiences included in and excluded from targeting
+	AudienceIDs         []string `json:"audience_ids,omitempty"`
+	ExcludedAudienceIDs []string `json:"excluded_audience_ids,omitempty"`
+	OperationStatus     string   `json:"operation_status"`
+	PrimaryStatus       string   `json:"primary_status,omitempty"`
+	SecondaryStatus     string   `json:"secondary_status,omitempty"`
+	CreateTime          string   `json:"create_time"`
+	ModifyTime          string   `json:"modify_time"`
+	ScheduleStartTime   string   `json:"schedule_start_time,omitempty"`
+	ScheduleEndTime     string   `json:"schedule_end_time,omitempty"`
+}
+
+// GetBudgetMode returns the ad group's budget mode as a typed value
+func (g *AdGroupInfo) GetBudgetMode() tiktok.BudgetMode {
+	return tiktok.BudgetMode(g.BudgetMode)
 }
 
 // GetAdGroupResponse represents the response for getting ad groups
@@ -53,11 +62,17 @@ type GetAdGroupResponse struct {
 
 // GetAdGroupRequest represents the request to get ad groups
 type GetAdGroupRequest struct {
+	tiktok.BaseListRequest
 	AdvertiserID string     `json:"advertiser_id"`
 	Filtering    *Filtering `json:"filtering,omitempty"`
-	Page         *int64     `json:"page,omitempty"`
-	PageSize     *int64     `json:"page_size,omitempty"`
-	Fields       []string   `json:"fields,omitempty"`
+	// ExcludeDeleted hides deleted ad groups by filtering on primary_status,
+	// unless Filtering already sets a primary status
+	ExcludeDeleted bool `json:"-"`
+	// ExcludeFieldTypesInResponse trims field groups from the response (e.g. tiktok.ExcludeFieldTypeCostData);
+	// excluded fields are left at their zero values
+	ExcludeFieldTypesInResponse []string `json:"exclude_field_types_in_response,omitempty"`
+	// CountOnly requests a single item and returns only PageInfo, leaving List empty
+	CountOnly bool `json:"-"`
 }
 
 // Filtering represents filtering options for ad groups
@@ -72,28 +87,49 @@ type Filtering struct {
 	CreateTimeMax   *string  `json:"create_time_max,omitempty"`
 }
 
+// filtering returns the filter to send, applying ExcludeDeleted without mutating the request
+func (r *GetAdGroupRequest) filtering() *Filtering {
+	if !r.ExcludeDeleted || (r.Filtering != nil && r.Filtering.PrimaryStatus != nil) {
+		return r.Filtering
+	}
+
+	var f Filtering
+	if r.Filtering != nil {
+		f = *r.Filtering
+	}
+	status := tiktok.PrimaryStatusNotDelete
+	f.PrimaryStatus = &status
+	return &f
+}
+
 // GetAdGroups gets ad group information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739314558673922
 func (a *API) GetAdGroups(ctx context.Context, req *GetAdGroupRequest) (*GetAdGroupResponse, error) {
-	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
 
-	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	})
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	// Add fields using helper
-	if err := tiktok.AddStringSlice(params, "fields", req.Fields); err != nil {
+	// Add pagination, fields and sorting using helper
+	if err := req.Apply(a.client, params); err != nil {
 		return nil, err
 	}
 
 	// Add filtering using helper
-	if err := tiktok.AddJSONParam(params, "filtering", req.Filtering); err != nil {
+	if err := tiktok.AddJSONParam(params, "filtering", req.filtering()); err != nil {
+		return nil, err
+	}
+
+	if err := tiktok.AddStringSlice(params, "exclude_field_types_in_response", req.ExcludeFieldTypesInResponse); err != nil {
 		return nil, err
 	}
 
+	if req.CountOnly {
+		return a.countAdGroups(ctx, params)
+	}
+
 	// Use generic DoGet helper
 	var resp GetAdGroupResponse
 	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/adgroup/get/", params, &resp); err != nil {
@@ -103,6 +139,36 @@ func (a *API) GetAdGroups(ctx context.Context, req *GetAdGroupRequest) (*GetAdGr
 	return &resp, nil
 }
 
+// GetAllAdGroups retrieves all ad groups by automatically handling pagination
+// This is a convenience method that calls GetAdGroups multiple times if needed
+func (a *API) GetAllAdGroups(ctx context.Context, req *GetAdGroupRequest) ([]AdGroupInfo, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	// Use maximum page size for efficiency
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]AdGroupInfo, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
+
+		resp, err := a.GetAdGroups(ctx, req)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to get ad groups page %d: %w", page, err)
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+}
+
+// GetAdGroupsByCampaign retrieves all ad groups in a campaign, handling pagination
+func (a *API) GetAdGroupsByCampaign(ctx context.Context, advertiserID, campaignID string) ([]AdGroupInfo, error) {
+	return a.GetAllAdGroups(ctx, &GetAdGroupRequest{
+		AdvertiserID: advertiserID,
+		Filtering: &Filtering{
+			CampaignIDs: []string{campaignID},
+		},
+	})
+}
+
 // CreateAdGroupRequest represents a simplified request to create an ad group
 // For full field list, see: https://business-api.tiktok.com/portal/docs?id=1739499616346114
 type CreateAdGroupRequest struct {
@@ -111,7 +177,7 @@ type CreateAdGroupRequest struct {
 	AdGroupName       string   `json:"adgroup_name"`
 	PromotionType     *string  `json:"promotion_type,omitempty"`
 	PlacementType     string   `json:"placement_type"`
-	Placements        []string `json:"placements"`
+	Placements        []string `json:"placements,omitempty"`
 	LocationIDs       []string `json:"location_ids"`
 	Languages         []string `json:"languages,omitempty"`
 	Gender            *string  `json:"gender,omitempty"`
@@ -121,12 +187,77 @@ type CreateAdGroupRequest struct {
 	ScheduleType      *string  `json:"schedule_type,omitempty"`
 	ScheduleStartTime *string  `json:"schedule_start_time,omitempty"`
 	ScheduleEndTime   *string  `json:"schedule_end_time,omitempty"`
+	Dayparting        *string  `json:"dayparting,omitempty"`
 	BillingEvent      string   `json:"billing_event"`
 	BidPrice          *float64 `json:"bid_price,omitempty"`
 	OptimizationGoal  string   `json:"optimization_goal"`
 	Pacing            *string  `json:"pacing,omitempty"`
 	PixelID           *string  `json:"pixel_id,omitempty"`
 	OperationStatus   *string  `json:"operation_status,omitempty"`
+
+	// AutoTargetingEnabled lets TikTok choose the audience; interest and behavior targeting must be empty
+	AutoTargetingEnabled *bool            `json:"auto_targeting_enabled,omitempty"`
+	InterestCategoryIDs  []string         `json:"interest_category_ids,omitempty"`
+	InterestKeywordIDs   []string         `json:"interest_keyword_ids,omitempty"`
+	Actions              []BehaviorAction `json:"actions,omitempty"`
+}
+
+// BehaviorAction represents a behavior targeting rule on an ad group.
+// Action category IDs come from tool.GetActionCategory.
+type BehaviorAction struct {
+	ActionScene       string   `json:"action_scene"`
+	ActionPeriod      int      `json:"action_period,omitempty"`
+	VideoUserActions  []string `json:"video_user_actions,omitempty"`
+	ActionCategoryIDs []string `json:"action_category_ids,omitempty"`
+}
+
+// Schedule types
+const (
+	ScheduleTypeStartEnd = "SCHEDULE_START_END"
+	ScheduleTypeFromNow  = "SCHEDULE_FROM_NOW"
+)
+
+// Placement types
+const (
+	// PlacementTypeAutomatic lets TikTok choose placements; Placements must be empty
+	PlacementTypeAutomatic = "PLACEMENT_TYPE_AUTOMATIC"
+	// PlacementTypeNormal uses the placements listed in Placements
+	PlacementTypeNormal = "PLACEMENT_TYPE_NORMAL"
+)
+
+// Validate checks the request for combinations the API would reject
+func (r *CreateAdGroupRequest) Validate() error {
+	mode := tiktok.BudgetMode(r.BudgetMode)
+	switch {
+	case mode.IsInfinite() && r.Budget != nil:
+		return errors.New("budget must not be set with BUDGET_MODE_INFINITE")
+	case (mode.IsDaily() || mode.IsTotal()) && (r.Budget == nil || *r.Budget <= 0):
+		return fmt.Errorf("a positive budget is required with %s", r.BudgetMode)
+	}
+
+	if r.ScheduleType != nil && *r.ScheduleType == ScheduleTypeStartEnd &&
+		(r.ScheduleEndTime == nil || *r.ScheduleEndTime == "") {
+		return errors.New("schedule end time is required with SCHEDULE_START_END")
+	}
+
+	switch {
+	case r.PlacementType == PlacementTypeAutomatic && len(r.Placements) > 0:
+		return fmt.Errorf("placements must not be set with %s, got %v", PlacementTypeAutomatic, r.Placements)
+	case r.PlacementType == PlacementTypeNormal && len(r.Placements) == 0:
+		return fmt.Errorf("at least one placement is required with %s", PlacementTypeNormal)
+	}
+
+	if r.BillingEvent != "" && r.OptimizationGoal != "" &&
+		!IsValidOptimizationCombo(r.BillingEvent, r.OptimizationGoal) {
+		return fmt.Errorf("optimization goal %s cannot be used with billing event %s", r.OptimizationGoal, r.BillingEvent)
+	}
+
+	if r.AutoTargetingEnabled != nil && *r.AutoTargetingEnabled &&
+		(len(r.InterestCategoryIDs) > 0 || len(r.InterestKeywordIDs) > 0 || len(r.Actions) > 0) {
+		return errors.New("interest and behavior targeting must not be set when auto targeting is enabled")
+	}
+
+	return nil
 }
 
 // CreateAdGroupResponse represents the response from creating an ad group
@@ -137,11 +268,117 @@ type CreateAdGroupResponse struct {
 // CreateAdGroup creates a new ad group with simplified parameters
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739499616346114
 func (a *API) CreateAdGroup(ctx context.Context, req *CreateAdGroupRequest) (*CreateAdGroupResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Use generic DoPost helper
 	var resp CreateAdGroupResponse
 	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/adgroup/create/", req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create ad group: %w", err)
 	}
 
+	a.client.Manifest().Record(tiktok.EntityTypeAdGroup, req.AdvertiserID, resp.AdGroupID)
+
 	return &resp, nil
 }
+
+// UpdateAdGroupRequest represents the request to update an ad group.
+// It mirrors CreateAdGroupRequest, but every field other than the IDs is optional
+// and only the fields that are set are sent, so a budget-only update sends just the budget.
+type UpdateAdGroupRequest struct {
+	AdvertiserID      string   `json:"advertiser_id"`
+	AdGroupID         string   `json:"adgroup_id"`
+	AdGroupName       *string  `json:"adgroup_name,omitempty"`
+	Placements        []string `json:"placements,omitempty"`
+	LocationIDs       []string `json:"location_ids,omitempty"`
+	Languages         []string `json:"languages,omitempty"`
+	Gender            *string  `json:"gender,omitempty"`
+	AgeGroups         []string `json:"age_groups,omitempty"`
+	Budget            *float64 `json:"budget,omitempty"`
+	ScheduleType      *string  `json:"schedule_type,omitempty"`
+	ScheduleStartTime *string  `json:"schedule_start_time,omitempty"`
+	ScheduleEndTime   *string  `json:"schedule_end_time,omitempty"`
+	Dayparting        *string  `json:"dayparting,omitempty"`
+	BidPrice          *float64 `json:"bid_price,omitempty"`
+	Pacing            *string  `json:"pacing,omitempty"`
+	PixelID           *string  `json:"pixel_id,omitempty"`
+
+	AutoTargetingEnabled *bool            `json:"auto_targeting_enabled,omitempty"`
+	InterestCategoryIDs  []string         `json:"interest_category_ids,omitempty"`
+	InterestKeywordIDs   []string         `json:"interest_keyword_ids,omitempty"`
+	Actions              []BehaviorAction `json:"actions,omitempty"`
+}
+
+// Validate checks the request for values the API would reject
+func (r *UpdateAdGroupRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return errors.New("advertiser ID is required")
+	}
+	if r.AdGroupID == "" {
+		return errors.New("ad group ID is required")
+	}
+	if r.Budget != nil && *r.Budget <= 0 {
+		return fmt.Errorf("budget must be positive, got %v", *r.Budget)
+	}
+	if r.ScheduleType != nil && *r.ScheduleType == ScheduleTypeStartEnd &&
+		(r.ScheduleEndTime == nil || *r.ScheduleEndTime == "") {
+		return errors.New("schedule end time is required with SCHEDULE_START_END")
+	}
+	if r.AutoTargetingEnabled != nil && *r.AutoTargetingEnabled &&
+		(len(r.InterestCategoryIDs) > 0 || len(r.InterestKeywordIDs) > 0 || len(r.Actions) > 0) {
+		return errors.New("interest and behavior targeting must not be set when auto targeting is enabled")
+	}
+	return nil
+}
+
+// UpdateAdGroupResponse represents the response from updating an ad group
+type UpdateAdGroupResponse struct {
+	AdGroupID string `json:"adgroup_id"`
+}
+
+// UpdateAdGroup updates the budget, bid, schedule or targeting of an ad group
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739586761631745
+func (a *API) UpdateAdGroup(ctx context.Context, req *UpdateAdGroupRequest) (*UpdateAdGroupResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	body := *req
+	body.AdvertiserID = tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID)
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateAdGroupResponse
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/adgroup/update/", &body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to update ad group: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// CreateAndGetAdGroup creates an ad group and fetches it, returning the full ad group
+func (a *API) CreateAndGetAdGroup(ctx context.Context, req *CreateAdGroupRequest) (*AdGroupInfo, error) {
+	created, err := a.CreateAdGroup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.GetAdGroups(ctx, &GetAdGroupRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering:    &Filtering{AdgroupIDs: []string{created.AdGroupID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ad group %s was created but could not be fetched: %w", created.AdGroupID, err)
+	}
+	if len(resp.List) == 0 {
+		return nil, fmt.Errorf("ad group %s was created but not found", created.AdGroupID)
+	}
+
+	return &resp.List[0], nil
+}
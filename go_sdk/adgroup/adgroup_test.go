@@ -131,9 +131,8 @@ func TestGetAdGroups_WithPagination(t *testing.T) {
 	page := int64(3)
 	pageSize := int64(25)
 	result, err := api.GetAdGroups(context.Background(), &GetAdGroupRequest{
-		AdvertiserID: "123456789",
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	})
 
 	require.NoError(t, err)
@@ -179,8 +178,8 @@ func TestGetAdGroups_WithFields(t *testing.T) {
 	api := NewAPI(client)
 
 	result, err := api.GetAdGroups(context.Background(), &GetAdGroupRequest{
-		AdvertiserID: "123456789",
-		Fields:       []string{"adgroup_id", "adgroup_name", "budget"},
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Fields: []string{"adgroup_id", "adgroup_name", "budget"}},
 	})
 
 	require.NoError(t, err)
@@ -376,6 +375,397 @@ func TestGetAdGroups_WithSchedule(t *testing.T) {
 	assert.Equal(t, "2024-12-31 23:59:59", result.List[0].ScheduleEndTime)
 }
 
+func TestGetAdGroupsByCampaign(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/open_api/v1.3/adgroup/get/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, `{"campaign_ids":["camp1"]}`, r.URL.Query().Get("filtering"))
+		assert.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"adgroup_id":"id1"},{"adgroup_id":"id2"}],"page_info":{"page":1,"page_size":100,"total_number":3,"total_page":2}}`
+		case "2":
+			data = `{"list":[{"adgroup_id":"id3"}],"page_info":{"page":2,"page_size":100,"total_number":3,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	result, err := api.GetAdGroupsByCampaign(context.Background(), "123456", "camp1")
+	require.NoError(t, err)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, "id1", result[0].AdgroupID)
+	assert.Equal(t, "id3", result[2].AdgroupID)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAdGroupInfo_GetBudgetMode(t *testing.T) {
+	g := AdGroupInfo{BudgetMode: "BUDGET_MODE_TOTAL"}
+
+	assert.Equal(t, tiktok.BudgetModeTotal, g.GetBudgetMode())
+	assert.True(t, g.GetBudgetMode().IsTotal())
+	assert.False(t, g.GetBudgetMode().IsDaily())
+}
+
+func TestCreateAdGroupRequest_Validate(t *testing.T) {
+	budget := 50.0
+	zero := 0.0
+	endTime := "2024-12-31 23:59:59"
+	startEnd := ScheduleTypeStartEnd
+	fromNow := ScheduleTypeFromNow
+	autoTargeting := true
+	manualTargeting := false
+
+	tests := []struct {
+		name    string
+		req     CreateAdGroupRequest
+		wantErr string
+	}{
+		{
+			name: "infinite without budget",
+			req:  CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_INFINITE"},
+		},
+		{
+			name: "daily with budget",
+			req:  CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_DAY", Budget: &budget},
+		},
+		{
+			name: "start end with end time",
+			req:  CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_TOTAL", Budget: &budget, ScheduleType: &startEnd, ScheduleEndTime: &endTime},
+		},
+		{
+			name: "from now without end time",
+			req:  CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_DAY", Budget: &budget, ScheduleType: &fromNow},
+		},
+		{
+			name:    "infinite with budget",
+			req:     CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_INFINITE", Budget: &budget},
+			wantErr: "budget must not be set with BUDGET_MODE_INFINITE",
+		},
+		{
+			name:    "daily without budget",
+			req:     CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_DAY"},
+			wantErr: "a positive budget is required with BUDGET_MODE_DAY",
+		},
+		{
+			name:    "total with zero budget",
+			req:     CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_TOTAL", Budget: &zero},
+			wantErr: "a positive budget is required with BUDGET_MODE_TOTAL",
+		},
+		{
+			name:    "start end without end time",
+			req:     CreateAdGroupRequest{BudgetMode: "BUDGET_MODE_DAY", Budget: &budget, ScheduleType: &startEnd},
+			wantErr: "schedule end time is required with SCHEDULE_START_END",
+		},
+		{
+			name: "automatic without placements",
+			req:  CreateAdGroupRequest{PlacementType: PlacementTypeAutomatic},
+		},
+		{
+			name: "normal with placements",
+			req:  CreateAdGroupRequest{PlacementType: PlacementTypeNormal, Placements: []string{"PLACEMENT_TIKTOK"}},
+		},
+		{
+			name:    "automatic with placements",
+			req:     CreateAdGroupRequest{PlacementType: PlacementTypeAutomatic, Placements: []string{"PLACEMENT_TIKTOK", "PLACEMENT_PANGLE"}},
+			wantErr: "placements must not be set with PLACEMENT_TYPE_AUTOMATIC, got [PLACEMENT_TIKTOK PLACEMENT_PANGLE]",
+		},
+		{
+			name:    "normal without placements",
+			req:     CreateAdGroupRequest{PlacementType: PlacementTypeNormal},
+			wantErr: "at least one placement is required with PLACEMENT_TYPE_NORMAL",
+		},
+		{
+			name: "cpc optimizing for clicks",
+			req:  CreateAdGroupRequest{BillingEvent: BillingEventCPC, OptimizationGoal: OptimizationGoalClick},
+		},
+		{
+			name:    "cpc optimizing for conversions",
+			req:     CreateAdGroupRequest{BillingEvent: BillingEventCPC, OptimizationGoal: OptimizationGoalConvert},
+			wantErr: "optimization goal CONVERT cannot be used with billing event CPC",
+		},
+		{
+			name: "auto targeting without manual targeting",
+			req:  CreateAdGroupRequest{AutoTargetingEnabled: &autoTargeting},
+		},
+		{
+			name: "manual targeting without auto targeting",
+			req:  CreateAdGroupRequest{AutoTargetingEnabled: &manualTargeting, InterestCategoryIDs: []string{"1001"}},
+		},
+		{
+			name:    "auto targeting with interests",
+			req:     CreateAdGroupRequest{AutoTargetingEnabled: &autoTargeting, InterestCategoryIDs: []string{"1001"}},
+			wantErr: "interest and behavior targeting must not be set when auto targeting is enabled",
+		},
+		{
+			name:    "auto targeting with behaviors",
+			req:     CreateAdGroupRequest{AutoTargetingEnabled: &autoTargeting, Actions: []BehaviorAction{{ActionScene: "VIDEO_RELATED", ActionCategoryIDs: []string{"2001"}}}},
+			wantErr: "interest and behavior targeting must not be set when auto targeting is enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateAdGroup_ValidationError(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+	budget := 10.0
+
+	_, err := api.CreateAdGroup(context.Background(), &CreateAdGroupRequest{
+		AdvertiserID: "123",
+		BudgetMode:   "BUDGET_MODE_INFINITE",
+		Budget:       &budget,
+	})
+	assert.EqualError(t, err, "budget must not be set with BUDGET_MODE_INFINITE")
+}
+
+func TestCreateAdGroupRequest_AutoTargetingJSON(t *testing.T) {
+	enabled := true
+	data, err := json.Marshal(CreateAdGroupRequest{AutoTargetingEnabled: &enabled})
+	require.NoError(t, err)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.Equal(t, true, body["auto_targeting_enabled"])
+	assert.NotContains(t, body, "interest_category_ids")
+	assert.NotContains(t, body, "actions")
+
+	data, err = json.Marshal(CreateAdGroupRequest{})
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "auto_targeting_enabled")
+}
+
+func TestGetAdGroups_ExcludeFieldTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `["COST_DATA"]`, r.URL.Query().Get("exclude_field_types_in_response"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [{"adgroup_id": "ag1", "adgroup_name": "No Cost", "campaign_id": "c1"}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAdGroups(context.Background(), &GetAdGroupRequest{
+		AdvertiserID:                "123",
+		ExcludeFieldTypesInResponse: []string{tiktok.ExcludeFieldTypeCostData},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.List, 1)
+	assert.Equal(t, "ag1", resp.List[0].AdgroupID)
+	assert.Zero(t, resp.List[0].Budget)
+}
+
+func TestCountAdGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/adgroup/get/", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("page_size"))
+
+		// budget is a string here, so decoding the list into AdGroupInfo would fail
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [{"adgroup_id": "ag1", "budget": "not-a-number"}],
+				"page_info": {"page": 1, "page_size": 1, "total_number": 15, "total_page": 15}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	count, err := api.CountAdGroups(context.Background(), "123", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), count)
+}
+
+func TestCreateAndGetAdGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data string
+		switch r.URL.Path {
+		case "/open_api/v1.3/adgroup/create/":
+			assert.Equal(t, http.MethodPost, r.Method)
+			data = `{"adgroup_id": "ag-001"}`
+		case "/open_api/v1.3/adgroup/get/":
+			assert.Equal(t, `{"adgroup_ids":["ag-001"]}`, r.URL.Query().Get("filtering"))
+			data = `{
+				"list": [{"adgroup_id": "ag-001", "adgroup_name": "New Group", "campaign_id": "c-001", "advertiser_id": "123456789", "budget": 50}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	info, err := api.CreateAndGetAdGroup(context.Background(), &CreateAdGroupRequest{
+		AdvertiserID: "123456789",
+		CampaignID:   "c-001",
+		AdGroupName:  "New Group",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ag-001", info.AdgroupID)
+	assert.Equal(t, "New Group", info.AdgroupName)
+	assert.Equal(t, "c-001", info.CampaignID)
+	assert.Equal(t, 50.0, info.Budget)
+}
+
+func TestCreateAndGetAdGroup_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := `{"list": [], "page_info": {}}`
+		if r.URL.Path == "/open_api/v1.3/adgroup/create/" {
+			data = `{"adgroup_id": "ag-001"}`
+		}
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.CreateAndGetAdGroup(context.Background(), &CreateAdGroupRequest{AdvertiserID: "123456789"})
+	assert.EqualError(t, err, "ad group ag-001 was created but not found")
+}
+
+func TestCreateAdGroup_RecordsManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{"adgroup_id": "ag-001"}`)})
+	}))
+	defer server.Close()
+
+	manifest := tiktok.NewManifest()
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil, tiktok.WithManifest(manifest))
+	api := NewAPI(client)
+
+	_, err := api.CreateAdGroup(context.Background(), &CreateAdGroupRequest{
+		AdvertiserID: "123456789",
+		CampaignID:   "c-001",
+		AdGroupName:  "New Group",
+	})
+	require.NoError(t, err)
+
+	entries := manifest.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, tiktok.EntityTypeAdGroup, entries[0].Type)
+	assert.Equal(t, "ag-001", entries[0].ID)
+	assert.Equal(t, "123456789", entries[0].AdvertiserID)
+}
+
+func TestUpdateAdGroup_BudgetOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/adgroup/update/", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"advertiser_id": "123456",
+			"adgroup_id":    "ag_1",
+			"budget":        75.5,
+		}, body)
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data":    map[string]interface{}{"adgroup_id": "ag_1"},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	budget := 75.5
+	resp, err := api.UpdateAdGroup(context.Background(), &UpdateAdGroupRequest{
+		AdvertiserID: "123456",
+		AdGroupID:    "ag_1",
+		Budget:       &budget,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ag_1", resp.AdGroupID)
+}
+
+func TestUpdateAdGroupRequest_Validate(t *testing.T) {
+	negative := -1.0
+	startEnd := ScheduleTypeStartEnd
+	autoTargeting := true
+
+	tests := []struct {
+		name    string
+		req     UpdateAdGroupRequest
+		wantErr string
+	}{
+		{"valid", UpdateAdGroupRequest{AdvertiserID: "123", AdGroupID: "ag_1", AdGroupName: ptrString("renamed")}, ""},
+		{"missing advertiser", UpdateAdGroupRequest{AdGroupID: "ag_1"}, "advertiser ID is required"},
+		{"missing ad group", UpdateAdGroupRequest{AdvertiserID: "123"}, "ad group ID is required"},
+		{"negative budget", UpdateAdGroupRequest{AdvertiserID: "123", AdGroupID: "ag_1", Budget: &negative}, "budget must be positive"},
+		{"start end without end time", UpdateAdGroupRequest{AdvertiserID: "123", AdGroupID: "ag_1", ScheduleType: &startEnd}, "schedule end time is required"},
+		{"auto targeting with interests", UpdateAdGroupRequest{
+			AdvertiserID: "123", AdGroupID: "ag_1", AutoTargetingEnabled: &autoTargeting, InterestCategoryIDs: []string{"1"},
+		}, "auto targeting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateAdGroup_NilRequest(t *testing.T) {
+	api := NewAPI(tiktok.NewClient("test-token"))
+
+	_, err := api.UpdateAdGroup(context.Background(), nil)
+	assert.ErrorIs(t, err, tiktok.ErrNilRequest)
+}
+
 // Helper functions
 func ptrInt64(i int64) *int64 {
 	return &i
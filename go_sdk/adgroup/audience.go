@@ -0,0 +1,40 @@
+package adgroup
+
+import (
+	"context"
+	"fmt"
+)
+
+// UsesAudience reports whether the ad group includes or excludes the custom audience
+func (g *AdGroupInfo) UsesAudience(audienceID string) bool {
+	for _, ids := range [][]string{g.AudienceIDs, g.ExcludedAudienceIDs} {
+		for _, id := range ids {
+			if id == audienceID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetAdGroupsUsingAudience gets all ad groups that include or exclude a custom audience.
+// The API cannot filter ad groups by audience, so every ad group of the advertiser
+// is fetched and matched locally on AudienceIDs and ExcludedAudienceIDs.
+func (a *API) GetAdGroupsUsingAudience(ctx context.Context, advertiserID, audienceID string) ([]AdGroupInfo, error) {
+	groups, err := a.GetAllAdGroups(ctx, &GetAdGroupRequest{
+		AdvertiserID:   advertiserID,
+		ExcludeDeleted: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ad groups using audience %s: %w", audienceID, err)
+	}
+
+	var matching []AdGroupInfo
+	for _, g := range groups {
+		if g.UsesAudience(audienceID) {
+			matching = append(matching, g)
+		}
+	}
+
+	return matching, nil
+}
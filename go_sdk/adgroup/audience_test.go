@@ -0,0 +1,53 @@
+package adgroup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetAdGroupsUsingAudience(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/adgroup/get/", r.URL.Path)
+		assert.Equal(t, "adv-123", r.URL.Query().Get("advertiser_id"))
+
+		response := tiktok.Response{
+			Code:    ptrInt64(0),
+			Message: ptrString("OK"),
+			Data: json.RawMessage(`{
+				"list": [
+					{"adgroup_id": "ag-1", "audience_ids": ["aud-1", "aud-2"]},
+					{"adgroup_id": "ag-2", "audience_ids": ["aud-3"]},
+					{"adgroup_id": "ag-3", "excluded_audience_ids": ["aud-1"]},
+					{"adgroup_id": "ag-4"}
+				],
+				"page_info": {"page": 1, "page_size": 1000, "total_number": 4, "total_page": 1}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	groups, err := api.GetAdGroupsUsingAudience(context.Background(), "adv-123", "aud-1")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "ag-1", groups[0].AdgroupID)
+	assert.Equal(t, "ag-3", groups[1].AdgroupID)
+}
+
+func TestAdGroupInfo_UsesAudience(t *testing.T) {
+	g := AdGroupInfo{AudienceIDs: []string{"aud-1"}, ExcludedAudienceIDs: []string{"aud-2"}}
+
+	assert.True(t, g.UsesAudience("aud-1"))
+	assert.True(t, g.UsesAudience("aud-2"))
+	assert.False(t, g.UsesAudience("aud-3"))
+}
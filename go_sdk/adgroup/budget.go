@@ -0,0 +1,14 @@
+package adgroup
+
+// FilterByBudget returns the ad groups whose budget is within [min, max], inclusive.
+// The ad group endpoint cannot filter by budget, so this runs over fetched results.
+// Ad groups with BUDGET_MODE_INFINITE have no budget and are never included.
+func FilterByBudget(adGroups []AdGroupInfo, min, max float64) []AdGroupInfo {
+	filtered := make([]AdGroupInfo, 0, len(adGroups))
+	for _, g := range adGroups {
+		if !g.GetBudgetMode().IsInfinite() && g.Budget >= min && g.Budget <= max {
+			filtered = append(filtered, g)
+		}
+	}
+	return filtered
+}
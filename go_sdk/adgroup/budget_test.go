@@ -0,0 +1,26 @@
+package adgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterByBudget(t *testing.T) {
+	adGroups := []AdGroupInfo{
+		{AdgroupID: "ag_low", BudgetMode: "BUDGET_MODE_DAY", Budget: 20},
+		{AdgroupID: "ag_min", BudgetMode: "BUDGET_MODE_DAY", Budget: 50},
+		{AdgroupID: "ag_mid", BudgetMode: "BUDGET_MODE_TOTAL", Budget: 120},
+		{AdgroupID: "ag_max", BudgetMode: "BUDGET_MODE_DAY", Budget: 200},
+		{AdgroupID: "ag_high", BudgetMode: "BUDGET_MODE_DAY", Budget: 500},
+		{AdgroupID: "ag_unlimited", BudgetMode: "BUDGET_MODE_INFINITE"},
+	}
+
+	filtered := FilterByBudget(adGroups, 50, 200)
+
+	ids := make([]string, len(filtered))
+	for i, g := range filtered {
+		ids[i] = g.AdgroupID
+	}
+	assert.Equal(t, []string{"ag_min", "ag_mid", "ag_max"}, ids)
+}
@@ -0,0 +1,40 @@
+package adgroup
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// countResponse decodes only the page info of a get response
+type countResponse struct {
+	PageInfo tiktok.PageInfo `json:"page_info"`
+}
+
+// countAdGroups fetches a single ad group and returns only the page info
+func (a *API) countAdGroups(ctx context.Context, params url.Values) (*GetAdGroupResponse, error) {
+	params.Set("page", "1")
+	params.Set("page_size", "1")
+
+	var resp countResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/adgroup/get/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to count ad groups: %w", err)
+	}
+
+	return &GetAdGroupResponse{PageInfo: resp.PageInfo}, nil
+}
+
+// CountAdGroups returns the number of ad groups matching filtering without fetching them
+func (a *API) CountAdGroups(ctx context.Context, advertiserID string, filtering *Filtering) (int64, error) {
+	resp, err := a.GetAdGroups(ctx, &GetAdGroupRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    filtering,
+		CountOnly:    true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.PageInfo.TotalNumber, nil
+}
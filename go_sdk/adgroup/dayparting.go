@@ -0,0 +1,87 @@
+package adgroup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// slotsPerDay is the number of half-hour slots in a dayparting day
+const slotsPerDay = 48
+
+// Dayparting builds the ad group dayparting schedule string.
+// The schedule is 336 characters: 48 half-hour slots for each day from
+// Monday to Sunday, where "1" means the ad group delivers in that slot.
+type Dayparting struct {
+	slots [7][slotsPerDay]bool
+	err   error
+}
+
+// NewDayparting returns an empty schedule with every slot inactive
+func NewDayparting() *Dayparting {
+	return &Dayparting{}
+}
+
+// SetHours activates [fromHour, toHour) on the given day, in the ad account timezone
+func (d *Dayparting) SetHours(day time.Weekday, fromHour, toHour int) *Dayparting {
+	return d.SetHalfHours(day, fromHour*2, toHour*2)
+}
+
+// SetHalfHours activates half-hour slots [fromSlot, toSlot) on the given day,
+// where slot 0 is 00:00-00:30 and slot 47 is 23:30-24:00
+func (d *Dayparting) SetHalfHours(day time.Weekday, fromSlot, toSlot int) *Dayparting {
+	if d.err != nil {
+		return d
+	}
+	if day < time.Sunday || day > time.Saturday {
+		d.err = fmt.Errorf("invalid weekday %d", day)
+		return d
+	}
+	if fromSlot < 0 || toSlot > slotsPerDay || fromSlot >= toSlot {
+		d.err = fmt.Errorf("invalid dayparting range %d-%d for %s", fromSlot, toSlot, day)
+		return d
+	}
+
+	// TikTok schedules start on Monday
+	row := (int(day) + 6) % 7
+	for i := fromSlot; i < toSlot; i++ {
+		d.slots[row][i] = true
+	}
+	return d
+}
+
+// SetWeekdays activates [fromHour, toHour) Monday through Friday
+func (d *Dayparting) SetWeekdays(fromHour, toHour int) *Dayparting {
+	for day := time.Monday; day <= time.Friday; day++ {
+		d.SetHours(day, fromHour, toHour)
+	}
+	return d
+}
+
+// SetEveryDay activates [fromHour, toHour) on all seven days
+func (d *Dayparting) SetEveryDay(fromHour, toHour int) *Dayparting {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		d.SetHours(day, fromHour, toHour)
+	}
+	return d
+}
+
+// Build returns the schedule string, or the first error from the builder methods
+func (d *Dayparting) Build() (string, error) {
+	if d.err != nil {
+		return "", d.err
+	}
+
+	var b strings.Builder
+	b.Grow(7 * slotsPerDay)
+	for _, day := range d.slots {
+		for _, active := range day {
+			if active {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		}
+	}
+	return b.String(), nil
+}
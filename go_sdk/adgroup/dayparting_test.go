@@ -0,0 +1,78 @@
+package adgroup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestDayparting_WeekdaysNineToFive(t *testing.T) {
+	schedule, err := NewDayparting().SetWeekdays(9, 17).Build()
+	require.NoError(t, err)
+
+	workday := strings.Repeat("0", 18) + strings.Repeat("1", 16) + strings.Repeat("0", 14)
+	weekend := strings.Repeat("0", 48)
+	assert.Equal(t, strings.Repeat(workday, 5)+weekend+weekend, schedule)
+	assert.Len(t, schedule, 336)
+}
+
+func TestDayparting_SundayIsLastDay(t *testing.T) {
+	schedule, err := NewDayparting().SetHalfHours(time.Sunday, 47, 48).Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Repeat("0", 335)+"1", schedule)
+}
+
+func TestDayparting_Empty(t *testing.T) {
+	schedule, err := NewDayparting().Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Repeat("0", 336), schedule)
+}
+
+func TestDayparting_InvalidRange(t *testing.T) {
+	_, err := NewDayparting().SetHours(time.Monday, 17, 9).SetWeekdays(9, 17).Build()
+	assert.Error(t, err)
+
+	_, err = NewDayparting().SetHours(time.Monday, 0, 25).Build()
+	assert.Error(t, err)
+}
+
+func TestCreateAdGroup_Dayparting(t *testing.T) {
+	schedule, err := NewDayparting().SetEveryDay(0, 12).Build()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, schedule, body["dayparting"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"adgroup_id":"ag1"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.CreateAdGroup(context.Background(), &CreateAdGroupRequest{
+		AdvertiserID: "123",
+		CampaignID:   "c1",
+		AdGroupName:  "Morning only",
+		BudgetMode:   "BUDGET_MODE_INFINITE",
+		Dayparting:   &schedule,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ag1", resp.AdGroupID)
+}
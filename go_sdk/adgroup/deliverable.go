@@ -0,0 +1,52 @@
+package adgroup
+
+import (
+	"context"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// Ad group secondary statuses
+const (
+	// SecondaryStatusDeliveryOK is the secondary status of an ad group that is delivering
+	SecondaryStatusDeliveryOK = "ADGROUP_STATUS_DELIVERY_OK"
+	// SecondaryStatusAudit means the ad group is under review
+	SecondaryStatusAudit = "ADGROUP_STATUS_AUDIT"
+	// SecondaryStatusReaudit means the edited ad group is under review again
+	SecondaryStatusReaudit = "ADGROUP_STATUS_REAUDIT"
+	// SecondaryStatusReject means the ad group was rejected in review
+	SecondaryStatusReject = "ADGROUP_STATUS_AUDIT_DENY"
+)
+
+// IsDeliverable reports whether the ad group is enabled and currently delivering
+func (g *AdGroupInfo) IsDeliverable() bool {
+	return g.OperationStatus == "ENABLE" && g.SecondaryStatus == SecondaryStatusDeliveryOK
+}
+
+// GetDeliverableAdGroups gets all ad groups that are enabled and currently delivering.
+// The delivery status filter is applied on the server and re-checked locally.
+func (a *API) GetDeliverableAdGroups(ctx context.Context, advertiserID string) ([]AdGroupInfo, error) {
+	primaryStatus := tiktok.PrimaryStatusDeliveryOK
+	secondaryStatus := SecondaryStatusDeliveryOK
+
+	groups, err := a.GetAllAdGroups(ctx, &GetAdGroupRequest{
+		AdvertiserID: advertiserID,
+		Filtering: &Filtering{
+			PrimaryStatus:   &primaryStatus,
+			SecondaryStatus: &secondaryStatus,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliverable ad groups: %w", err)
+	}
+
+	var deliverable []AdGroupInfo
+	for _, g := range groups {
+		if g.IsDeliverable() {
+			deliverable = append(deliverable, g)
+		}
+	}
+
+	return deliverable, nil
+}
@@ -0,0 +1,63 @@
+package adgroup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetDeliverableAdGroups(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/open_api/v1.3/adgroup/get/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.JSONEq(t, `{"primary_status":"STATUS_DELIVERY_OK","secondary_status":"ADGROUP_STATUS_DELIVERY_OK"}`, r.URL.Query().Get("filtering"))
+
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{
+				"list": [
+					{"adgroup_id": "ag1", "operation_status": "ENABLE", "secondary_status": "ADGROUP_STATUS_DELIVERY_OK"},
+					{"adgroup_id": "ag2", "operation_status": "DISABLE", "secondary_status": "ADGROUP_STATUS_DISABLE"}
+				],
+				"page_info": {"page": 1, "page_size": 100, "total_number": 4, "total_page": 2}
+			}`
+		case "2":
+			data = `{
+				"list": [
+					{"adgroup_id": "ag3", "operation_status": "ENABLE", "secondary_status": "ADGROUP_STATUS_BUDGET_EXCEED"},
+					{"adgroup_id": "ag4", "operation_status": "ENABLE", "secondary_status": "ADGROUP_STATUS_DELIVERY_OK"}
+				],
+				"page_info": {"page": 2, "page_size": 100, "total_number": 4, "total_page": 2}
+			}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	groups, err := api.GetDeliverableAdGroups(context.Background(), "123456")
+	require.NoError(t, err)
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, "ag1", groups[0].AdgroupID)
+	assert.Equal(t, "ag4", groups[1].AdgroupID)
+	assert.Equal(t, 2, calls)
+}
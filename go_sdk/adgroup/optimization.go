@@ -0,0 +1,73 @@
+package adgroup
+
+// Billing events
+const (
+	BillingEventCPC  = "CPC"
+	BillingEventCPM  = "CPM"
+	BillingEventCPV  = "CPV"
+	BillingEventOCPM = "OCPM"
+)
+
+// Optimization goals
+const (
+	OptimizationGoalClick          = "CLICK"
+	OptimizationGoalReach          = "REACH"
+	OptimizationGoalVideoView      = "VIDEO_VIEW"
+	OptimizationGoalEngagedView    = "ENGAGED_VIEW"
+	OptimizationGoalConvert        = "CONVERT"
+	OptimizationGoalInstall        = "INSTALL"
+	OptimizationGoalInAppEvent     = "IN_APP_EVENT"
+	OptimizationGoalValue          = "VALUE"
+	OptimizationGoalLeadGeneration = "LEAD_GENERATION"
+	OptimizationGoalLandingPage    = "TRAFFIC_LANDING_PAGE_VIEW"
+	OptimizationGoalFollowers      = "FOLLOWERS"
+	OptimizationGoalProfileViews   = "PROFILE_VIEWS"
+)
+
+// optimizationGoalsByBilling lists the optimization goals each billing event can be used with.
+// Conversion goals are only available with oCPM billing.
+var optimizationGoalsByBilling = map[string][]string{
+	BillingEventCPC: {OptimizationGoalClick},
+	BillingEventCPM: {OptimizationGoalReach},
+	BillingEventCPV: {OptimizationGoalVideoView, OptimizationGoalEngagedView},
+	BillingEventOCPM: {
+		OptimizationGoalClick,
+		OptimizationGoalReach,
+		OptimizationGoalConvert,
+		OptimizationGoalInstall,
+		OptimizationGoalInAppEvent,
+		OptimizationGoalValue,
+		OptimizationGoalLeadGeneration,
+		OptimizationGoalLandingPage,
+		OptimizationGoalFollowers,
+		OptimizationGoalProfileViews,
+	},
+}
+
+// knownOptimizationGoals is every goal listed in optimizationGoalsByBilling
+var knownOptimizationGoals = func() map[string]bool {
+	known := make(map[string]bool)
+	for _, goals := range optimizationGoalsByBilling {
+		for _, goal := range goals {
+			known[goal] = true
+		}
+	}
+	return known
+}()
+
+// IsValidOptimizationCombo reports whether an ad group can be billed by billingEvent
+// while optimizing for optimizationGoal. Only pairs of a known billing event and a known
+// goal are checked; any other combination is reported as valid and left to the API,
+// which supports more goals (shop, live, ...) than are listed here.
+func IsValidOptimizationCombo(billingEvent, optimizationGoal string) bool {
+	goals, ok := optimizationGoalsByBilling[billingEvent]
+	if !ok || !knownOptimizationGoals[optimizationGoal] {
+		return true
+	}
+	for _, goal := range goals {
+		if goal == optimizationGoal {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package adgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestIsValidOptimizationCombo(t *testing.T) {
+	tests := []struct {
+		billingEvent     string
+		optimizationGoal string
+		want             bool
+	}{
+		{BillingEventCPC, OptimizationGoalClick, true},
+		{BillingEventCPM, OptimizationGoalReach, true},
+		{BillingEventCPV, OptimizationGoalVideoView, true},
+		{BillingEventOCPM, OptimizationGoalConvert, true},
+		{BillingEventOCPM, OptimizationGoalClick, true},
+		{BillingEventCPC, OptimizationGoalConvert, false},
+		{BillingEventCPM, OptimizationGoalInstall, false},
+		{BillingEventCPV, OptimizationGoalClick, false},
+		{"UNKNOWN", OptimizationGoalClick, true},
+		{BillingEventOCPM, "GMV", true},
+		{BillingEventCPC, "PRODUCT_CLICK_IN_LIVE", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.billingEvent+"/"+tt.optimizationGoal, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsValidOptimizationCombo(tt.billingEvent, tt.optimizationGoal))
+		})
+	}
+}
+
+func TestCreateAdGroup_InvalidOptimizationCombo(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.CreateAdGroup(context.Background(), &CreateAdGroupRequest{
+		AdvertiserID:     "123",
+		BillingEvent:     BillingEventCPC,
+		OptimizationGoal: OptimizationGoalConvert,
+	})
+	assert.EqualError(t, err, "optimization goal CONVERT cannot be used with billing event CPC")
+}
@@ -0,0 +1,19 @@
+package adgroup
+
+import (
+	"time"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// ActiveFilter returns a filter matching ad groups that are currently delivering
+func ActiveFilter() *Filtering {
+	status := tiktok.PrimaryStatusDeliveryOK
+	return &Filtering{PrimaryStatus: &status}
+}
+
+// CreatedInLastDays returns a filter matching ad groups created in the last n days
+func CreatedInLastDays(n int) *Filtering {
+	createTimeMin := time.Now().UTC().AddDate(0, 0, -n).Format(tiktok.FilterTimeLayout)
+	return &Filtering{CreateTimeMin: &createTimeMin}
+}
@@ -0,0 +1,27 @@
+package adgroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestActiveFilter(t *testing.T) {
+	f := ActiveFilter()
+	require.NotNil(t, f.PrimaryStatus)
+	assert.Equal(t, tiktok.PrimaryStatusDeliveryOK, *f.PrimaryStatus)
+	assert.Nil(t, f.CreateTimeMin)
+}
+
+func TestCreatedInLastDays(t *testing.T) {
+	f := CreatedInLastDays(30)
+	require.NotNil(t, f.CreateTimeMin)
+	assert.Nil(t, f.PrimaryStatus)
+
+	createTimeMin, err := time.ParseInLocation(tiktok.FilterTimeLayout, *f.CreateTimeMin, time.UTC)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -30), createTimeMin, time.Minute)
+}
@@ -0,0 +1,24 @@
+package adgroup
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduleTimeLayout is the layout of ScheduleStartTime and ScheduleEndTime.
+// Schedule times are interpreted by the API in UTC+0, not the ad account timezone.
+const ScheduleTimeLayout = "2006-01-02 15:04:05"
+
+// ScheduleTime formats t as an ad group schedule time, converting it to UTC
+func ScheduleTime(t time.Time) string {
+	return t.UTC().Format(ScheduleTimeLayout)
+}
+
+// ParseScheduleTime parses an ad group schedule time as a UTC instant
+func ParseScheduleTime(s string) (time.Time, error) {
+	t, err := time.ParseInLocation(ScheduleTimeLayout, s, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule time %q, expected YYYY-MM-DD HH:MM:SS: %w", s, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,42 @@
+package adgroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	local := time.Date(2024, 3, 1, 9, 30, 0, 0, tokyo)
+
+	s := ScheduleTime(local)
+	assert.Equal(t, "2024-03-01 00:30:00", s)
+
+	parsed, err := ParseScheduleTime(s)
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(local))
+	assert.Equal(t, time.UTC, parsed.Location())
+}
+
+func TestScheduleTime_DropsSubseconds(t *testing.T) {
+	assert.Equal(t, "2024-03-01 00:30:00", ScheduleTime(time.Date(2024, 3, 1, 0, 30, 0, 999, time.UTC)))
+}
+
+func TestParseScheduleTime_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2024-03-01",
+		"2024-03-01T00:30:00Z",
+		"2024/03/01 00:30:00",
+		"2024-13-01 00:30:00",
+		"2024-03-01 24:30:00",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, err := ParseScheduleTime(s)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultAdvertiserMetaTTL is how long AdvertiserMeta caches an advertiser's metadata
+const DefaultAdvertiserMetaTTL = time.Hour
+
+// advertiserInfoBatchSize is the maximum number of advertiser IDs per advertiser info request
+const advertiserInfoBatchSize = 100
+
+// errAdvertiserNotFound is returned for advertisers missing from the advertiser info response
+var errAdvertiserNotFound = errors.New("advertiser not found")
+
+// advertiserMetaFields are the advertiser info fields fetched by AdvertiserMeta
+var advertiserMetaFields = []string{"advertiser_id", "currency", "timezone"}
+
+// AdvertiserMeta is the currency and timezone of an advertiser account
+type AdvertiserMeta struct {
+	AdvertiserID string `json:"advertiser_id"`
+	Currency     string `json:"currency"`
+	Timezone     string `json:"timezone"`
+}
+
+// advertiserMetaEntry is a cached or in-flight advertiser lookup; done is closed
+// once meta and err are set
+type advertiserMetaEntry struct {
+	done    chan struct{}
+	meta    AdvertiserMeta
+	err     error
+	expires time.Time
+}
+
+// advertiserMetaCache caches advertiser metadata by advertiser ID. Concurrent lookups
+// of the same advertiser share a single request.
+type advertiserMetaCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*advertiserMetaEntry
+	now     func() time.Time
+}
+
+func newAdvertiserMetaCache(ttl time.Duration) *advertiserMetaCache {
+	return &advertiserMetaCache{
+		ttl:     ttl,
+		entries: make(map[string]*advertiserMetaEntry),
+		now:     time.Now,
+	}
+}
+
+// WithAdvertiserMetaTTL sets how long AdvertiserMeta caches an advertiser's metadata
+// (DefaultAdvertiserMetaTTL by default). A TTL of 0 or less disables the cache.
+func WithAdvertiserMetaTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		if ttl <= 0 {
+			c.advertiserMeta = nil
+			return
+		}
+		c.advertiserMeta = newAdvertiserMetaCache(ttl)
+	}
+}
+
+// AdvertiserMeta returns the currency and timezone of an advertiser, for helpers such as
+// date conversion and money formatting. Results are cached per advertiser for the
+// client's TTL, and concurrent lookups of the same advertiser share one request.
+func (c *Client) AdvertiserMeta(ctx context.Context, advertiserID string) (AdvertiserMeta, error) {
+	advertiserID = ResolveAdvertiserID(ctx, advertiserID)
+	if advertiserID == "" {
+		return AdvertiserMeta{}, fmt.Errorf("failed to get advertiser meta: advertiser ID is required")
+	}
+
+	entries, err := c.advertiserMetaEntries(ctx, []string{advertiserID})
+	if err != nil {
+		return AdvertiserMeta{}, err
+	}
+	entry := entries[advertiserID]
+	return entry.meta, entry.err
+}
+
+// AdvertiserMetas is AdvertiserMeta for several advertisers. Advertisers not cached are
+// fetched in batches of advertiserInfoBatchSize, sharing the cache with AdvertiserMeta.
+// Advertisers the API does not return are left out of the result.
+func (c *Client) AdvertiserMetas(ctx context.Context, advertiserIDs []string) (map[string]AdvertiserMeta, error) {
+	entries, err := c.advertiserMetaEntries(ctx, advertiserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	metas := make(map[string]AdvertiserMeta, len(entries))
+	for id, entry := range entries {
+		switch {
+		case entry.err == nil:
+			metas[id] = entry.meta
+		case !errors.Is(entry.err, errAdvertiserNotFound):
+			return nil, entry.err
+		}
+	}
+	return metas, nil
+}
+
+// advertiserMetaEntries returns a finished lookup for every advertiser in advertiserIDs.
+// Cached and in-flight lookups are reused; the rest are fetched by this call.
+func (c *Client) advertiserMetaEntries(ctx context.Context, advertiserIDs []string) (map[string]*advertiserMetaEntry, error) {
+	entries := make(map[string]*advertiserMetaEntry, len(advertiserIDs))
+	var owned []string
+
+	mc := c.advertiserMeta
+	if mc != nil {
+		mc.mu.Lock()
+		mc.pruneExpired()
+	}
+	for _, id := range advertiserIDs {
+		if id == "" || entries[id] != nil {
+			continue
+		}
+		if mc != nil {
+			if entry, ok := mc.entries[id]; ok {
+				entries[id] = entry
+				continue
+			}
+		}
+		entry := &advertiserMetaEntry{done: make(chan struct{})}
+		entries[id] = entry
+		owned = append(owned, id)
+		if mc != nil {
+			mc.entries[id] = entry
+		}
+	}
+	if mc != nil {
+		mc.mu.Unlock()
+	}
+
+	for start := 0; start < len(owned); start += advertiserInfoBatchSize {
+		end := start + advertiserInfoBatchSize
+		if end > len(owned) {
+			end = len(owned)
+		}
+		c.fillAdvertiserMeta(ctx, owned[start:end], entries)
+	}
+
+	for _, entry := range entries {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-entry.done:
+		}
+	}
+	return entries, nil
+}
+
+// fillAdvertiserMeta fetches one batch of advertisers and finishes their entries.
+// Failed lookups are removed from the cache so the next call fetches them again.
+func (c *Client) fillAdvertiserMeta(ctx context.Context, advertiserIDs []string, entries map[string]*advertiserMetaEntry) {
+	metas, err := c.fetchAdvertiserMetas(ctx, advertiserIDs)
+	for _, id := range advertiserIDs {
+		entry := entries[id]
+		switch meta, ok := metas[id]; {
+		case err != nil:
+			entry.err = err
+		case !ok:
+			entry.err = fmt.Errorf("failed to get advertiser meta: advertiser %s: %w", id, errAdvertiserNotFound)
+		default:
+			entry.meta = meta
+		}
+	}
+
+	if mc := c.advertiserMeta; mc != nil {
+		mc.mu.Lock()
+		for _, id := range advertiserIDs {
+			entry := entries[id]
+			entry.expires = mc.now().Add(mc.ttl)
+			if entry.err != nil && mc.entries[id] == entry {
+				delete(mc.entries, id)
+			}
+		}
+		mc.mu.Unlock()
+	}
+
+	for _, id := range advertiserIDs {
+		close(entries[id].done)
+	}
+}
+
+// pruneExpired drops finished entries past their TTL. The caller must hold mc.mu.
+func (mc *advertiserMetaCache) pruneExpired() {
+	now := mc.now()
+	for id, entry := range mc.entries {
+		select {
+		case <-entry.done:
+			if !now.Before(entry.expires) {
+				delete(mc.entries, id)
+			}
+		default:
+		}
+	}
+}
+
+// fetchAdvertiserMetas gets the metadata of up to advertiserInfoBatchSize advertisers
+// from the advertiser info endpoint
+func (c *Client) fetchAdvertiserMetas(ctx context.Context, advertiserIDs []string) (map[string]AdvertiserMeta, error) {
+	params := url.Values{}
+	if err := AddStringSlice(params, "advertiser_ids", advertiserIDs); err != nil {
+		return nil, err
+	}
+	if err := AddStringSlice(params, "fields", advertiserMetaFields); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		List []AdvertiserMeta `json:"list"`
+	}
+	if err := DoGet(ctx, c, "/open_api/v1.3/advertiser/info/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get advertiser meta: %w", err)
+	}
+
+	metas := make(map[string]AdvertiserMeta, len(resp.List))
+	for _, meta := range resp.List {
+		metas[meta.AdvertiserID] = meta
+	}
+	return metas, nil
+}
@@ -0,0 +1,168 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdvertiserMetaServer(t *testing.T, calls *int32, started chan<- struct{}, release <-chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/advertiser/info/", r.URL.Path)
+		assert.Equal(t, `["adv-123"]`, r.URL.Query().Get("advertiser_ids"))
+		assert.Equal(t, `["advertiser_id","currency","timezone"]`, r.URL.Query().Get("fields"))
+
+		atomic.AddInt32(calls, 1)
+		if started != nil {
+			started <- struct{}{}
+		}
+		if release != nil {
+			<-release
+		}
+
+		json.NewEncoder(w).Encode(Response{
+			Code:    ptrInt64(0),
+			Message: ptrString("OK"),
+			Data:    json.RawMessage(`{"list":[{"advertiser_id":"adv-123","currency":"JPY","timezone":"Asia/Tokyo"}]}`),
+		})
+	}))
+}
+
+func TestClient_AdvertiserMeta_ConcurrentLookups(t *testing.T) {
+	var calls int32
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := newAdvertiserMetaServer(t, &calls, started, release)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+
+	var wg sync.WaitGroup
+	results := make([]AdvertiserMeta, 2)
+	errs := make([]error, 2)
+	lookup := func(i int) {
+		defer wg.Done()
+		results[i], errs[i] = client.AdvertiserMeta(context.Background(), "adv-123")
+	}
+
+	wg.Add(2)
+	go lookup(0)
+	<-started
+	go lookup(1)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	want := AdvertiserMeta{AdvertiserID: "adv-123", Currency: "JPY", Timezone: "Asia/Tokyo"}
+	for i := range results {
+		require.NoError(t, errs[i])
+		assert.Equal(t, want, results[i])
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClient_AdvertiserMeta_TTL(t *testing.T) {
+	var calls int32
+	server := newAdvertiserMetaServer(t, &calls, nil, nil)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithAdvertiserMetaTTL(time.Minute))
+	now := time.Now()
+	client.advertiserMeta.now = func() time.Time { return now }
+
+	_, err := client.AdvertiserMeta(context.Background(), "adv-123")
+	require.NoError(t, err)
+	_, err = client.AdvertiserMeta(context.Background(), "adv-123")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	now = now.Add(2 * time.Minute)
+	_, err = client.AdvertiserMeta(context.Background(), "adv-123")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestClient_AdvertiserMeta_ErrorsNotCached(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			json.NewEncoder(w).Encode(Response{Code: ptrInt64(50002), Message: ptrString("Internal error")})
+			return
+		}
+		json.NewEncoder(w).Encode(Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"advertiser_id":"adv-123","currency":"USD","timezone":"UTC"}]}`),
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+
+	_, err := client.AdvertiserMeta(context.Background(), "adv-123")
+	require.Error(t, err)
+
+	meta, err := client.AdvertiserMeta(context.Background(), "adv-123")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", meta.Currency)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestClient_AdvertiserMetas(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("advertiser_ids"))
+		json.NewEncoder(w).Encode(Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"advertiser_id":"adv-2","currency":"USD","timezone":"UTC"}]}`),
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+	client.advertiserMeta.entries["adv-1"] = &advertiserMetaEntry{
+		done:    closedChan(),
+		meta:    AdvertiserMeta{AdvertiserID: "adv-1", Currency: "JPY", Timezone: "Asia/Tokyo"},
+		expires: time.Now().Add(time.Hour),
+	}
+
+	metas, err := client.AdvertiserMetas(context.Background(), []string{"adv-1", "adv-2", "adv-3", "adv-2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`["adv-2","adv-3"]`}, requested)
+	assert.Equal(t, map[string]AdvertiserMeta{
+		"adv-1": {AdvertiserID: "adv-1", Currency: "JPY", Timezone: "Asia/Tokyo"},
+		"adv-2": {AdvertiserID: "adv-2", Currency: "USD", Timezone: "UTC"},
+	}, metas)
+
+	_, err = client.AdvertiserMeta(context.Background(), "adv-3")
+	assert.ErrorIs(t, err, errAdvertiserNotFound)
+}
+
+func TestClient_AdvertiserMeta_PrunesExpired(t *testing.T) {
+	var calls int32
+	server := newAdvertiserMetaServer(t, &calls, nil, nil)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithAdvertiserMetaTTL(time.Minute))
+	now := time.Now()
+	client.advertiserMeta.now = func() time.Time { return now }
+	client.advertiserMeta.entries["adv-old"] = &advertiserMetaEntry{done: closedChan(), expires: now.Add(-time.Second)}
+
+	_, err := client.AdvertiserMeta(context.Background(), "adv-123")
+	require.NoError(t, err)
+	assert.NotContains(t, client.advertiserMeta.entries, "adv-old")
+}
+
+func closedChan() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
@@ -50,12 +50,14 @@ type CustomAudienceGetRequest struct {
 // GetCustomAudiences obtains the details of specified audiences
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739940507792385
 func (a *API) GetCustomAudiences(ctx context.Context, req *CustomAudienceGetRequest) (*CustomAudienceGetResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	for _, id := range req.CustomAudienceIDs {
-		params.Add("custom_audience_ids", id)
-	}
+	tiktok.AddRepeatedParam(params, "custom_audience_ids", req.CustomAudienceIDs)
 
 	if req.HistorySize != nil {
 		params.Set("history_size", strconv.FormatInt(*req.HistorySize, 10))
@@ -87,17 +89,17 @@ type CustomAudienceListRequest struct {
 // ListCustomAudiences gets all audiences
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739940506015746
 func (a *API) ListCustomAudiences(ctx context.Context, req *CustomAudienceListRequest) (*CustomAudienceListResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	if len(req.CustomAudienceIDs) > 0 {
-		for _, id := range req.CustomAudienceIDs {
-			params.Add("custom_audience_ids", id)
-		}
-	}
+	tiktok.AddRepeatedParam(params, "custom_audience_ids", req.CustomAudienceIDs)
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -110,3 +112,20 @@ func (a *API) ListCustomAudiences(ctx context.Context, req *CustomAudienceListRe
 
 	return &resp, nil
 }
+
+// GetAllCustomAudiences retrieves all custom audiences of an advertiser by automatically handling pagination
+func (a *API) GetAllCustomAudiences(ctx context.Context, advertiserID string) ([]CustomAudienceInfo, error) {
+	req := &CustomAudienceListRequest{AdvertiserID: advertiserID}
+
+	// Use maximum page size for efficiency
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]CustomAudienceInfo, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
+
+		resp, err := a.ListCustomAudiences(ctx, req)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to list custom audiences page %d: %w", page, err)
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+}
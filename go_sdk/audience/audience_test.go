@@ -3,6 +3,7 @@ package audience
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -26,9 +27,7 @@ func TestGetCustomAudiences_Success(t *testing.T) {
 		assert.Contains(t, r.URL.Path, "/open_api/v1.3/dmp/custom_audience/get/")
 		assert.Equal(t, "123456789", r.URL.Query().Get("advertiser_id"))
 
-		customAudienceIDs := r.URL.Query()["custom_audience_ids"]
-		assert.Contains(t, customAudienceIDs, "audience-001")
-		assert.Contains(t, customAudienceIDs, "audience-002")
+		assert.Equal(t, []string{"audience-001", "audience-002"}, r.URL.Query()["custom_audience_ids"])
 
 		audienceData := CustomAudienceGetResponse{
 			List: []CustomAudienceInfo{
@@ -127,9 +126,7 @@ func TestGetCustomAudiences_WithHistorySize(t *testing.T) {
 
 func TestGetCustomAudiences_SingleAudience(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		customAudienceIDs := r.URL.Query()["custom_audience_ids"]
-		assert.Len(t, customAudienceIDs, 1)
-		assert.Equal(t, "audience-single", customAudienceIDs[0])
+		assert.Equal(t, []string{"audience-single"}, r.URL.Query()["custom_audience_ids"])
 
 		audienceData := CustomAudienceGetResponse{
 			List: []CustomAudienceInfo{
@@ -271,9 +268,7 @@ func TestListCustomAudiences_WithPagination(t *testing.T) {
 
 func TestListCustomAudiences_WithAudienceIDs(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		customAudienceIDs := r.URL.Query()["custom_audience_ids"]
-		assert.Contains(t, customAudienceIDs, "audience-a")
-		assert.Contains(t, customAudienceIDs, "audience-b")
+		assert.Equal(t, []string{"audience-a", "audience-b"}, r.URL.Query()["custom_audience_ids"])
 
 		audienceData := CustomAudienceListResponse{
 			List: []CustomAudienceInfo{
@@ -350,6 +345,63 @@ func TestListCustomAudiences_EmptyResult(t *testing.T) {
 	assert.Equal(t, int64(0), result.PageInfo.TotalNumber)
 }
 
+func TestGetAllCustomAudiences(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/open_api/v1.3/dmp/custom_audience/list/", r.URL.Path)
+		assert.Equal(t, "123456789", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"custom_audience_id":"a1"},{"custom_audience_id":"a2"}],"page_info":{"page":1,"page_size":100,"total_number":5,"total_page":3}}`
+		case "2":
+			data = `{"list":[{"custom_audience_id":"a3"},{"custom_audience_id":"a4"}],"page_info":{"page":2,"page_size":100,"total_number":5,"total_page":3}}`
+		case "3":
+			data = `{"list":[{"custom_audience_id":"a5"}],"page_info":{"page":3,"page_size":100,"total_number":5,"total_page":3}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	audiences, err := api.GetAllCustomAudiences(context.Background(), "123456789")
+	require.NoError(t, err)
+
+	require.Len(t, audiences, 5)
+	for i, a := range audiences {
+		assert.Equal(t, fmt.Sprintf("a%d", i+1), a.CustomAudienceID)
+	}
+	assert.Equal(t, 3, calls)
+}
+
+func TestGetAllCustomAudiences_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := api.GetAllCustomAudiences(ctx, "123456789")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 // Helper functions
 func ptrInt64(i int64) *int64 {
 	return &i
@@ -0,0 +1,70 @@
+package audience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// Customer File audience update actions
+const (
+	// FileAudienceActionAppend adds the users in the files to the audience
+	FileAudienceActionAppend = "APPEND"
+	// FileAudienceActionReplace replaces the audience with the users in the files
+	FileAudienceActionReplace = "REPLACE"
+	// FileAudienceActionDelete removes the users in the files from the audience
+	FileAudienceActionDelete = "DELETE"
+)
+
+var fileAudienceActions = map[string]bool{
+	FileAudienceActionAppend:  true,
+	FileAudienceActionReplace: true,
+	FileAudienceActionDelete:  true,
+}
+
+// FileAudienceUpdateRequest represents the request to update a Customer File audience
+type FileAudienceUpdateRequest struct {
+	AdvertiserID     string   `json:"advertiser_id"`
+	CustomAudienceID string   `json:"custom_audience_id"`
+	Action           string   `json:"action"`
+	FilePaths        []string `json:"file_paths"`
+}
+
+// Validate checks the request for values the API would reject
+func (r *FileAudienceUpdateRequest) Validate() error {
+	if r.CustomAudienceID == "" {
+		return errors.New("custom audience ID is required")
+	}
+	if !fileAudienceActions[r.Action] {
+		return fmt.Errorf("invalid action %q", r.Action)
+	}
+	if len(r.FilePaths) == 0 {
+		return errors.New("at least one file path is required")
+	}
+	return nil
+}
+
+// UpdateFileAudience appends, replaces or removes users of a Customer File audience
+// using files uploaded with the audience file upload endpoint
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739940572667906
+func (a *API) UpdateFileAudience(ctx context.Context, req *FileAudienceUpdateRequest) error {
+	if req == nil {
+		return tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	body := *req
+	body.AdvertiserID = tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID)
+
+	var resp struct{}
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/dmp/custom_audience/update/", &body, &resp); err != nil {
+		return fmt.Errorf("failed to update file audience: %w", err)
+	}
+
+	return nil
+}
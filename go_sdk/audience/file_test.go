@@ -0,0 +1,88 @@
+package audience
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestUpdateFileAudience_Append(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/dmp/custom_audience/update/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "123456789", body["advertiser_id"])
+		assert.Equal(t, "audience-001", body["custom_audience_id"])
+		assert.Equal(t, "APPEND", body["action"])
+		assert.Equal(t, []interface{}{"file-path-1", "file-path-2"}, body["file_paths"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.UpdateFileAudience(context.Background(), &FileAudienceUpdateRequest{
+		AdvertiserID:     "123456789",
+		CustomAudienceID: "audience-001",
+		Action:           FileAudienceActionAppend,
+		FilePaths:        []string{"file-path-1", "file-path-2"},
+	})
+	require.NoError(t, err)
+}
+
+func TestFileAudienceUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     FileAudienceUpdateRequest
+		wantErr bool
+	}{
+		{
+			name: "append",
+			req:  FileAudienceUpdateRequest{CustomAudienceID: "a1", Action: FileAudienceActionAppend, FilePaths: []string{"f1"}},
+		},
+		{
+			name: "delete",
+			req:  FileAudienceUpdateRequest{CustomAudienceID: "a1", Action: FileAudienceActionDelete, FilePaths: []string{"f1"}},
+		},
+		{
+			name:    "unknown action",
+			req:     FileAudienceUpdateRequest{CustomAudienceID: "a1", Action: "REMOVE", FilePaths: []string{"f1"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing files",
+			req:     FileAudienceUpdateRequest{CustomAudienceID: "a1", Action: FileAudienceActionReplace},
+			wantErr: true,
+		},
+		{
+			name:    "missing audience ID",
+			req:     FileAudienceUpdateRequest{Action: FileAudienceActionAppend, FilePaths: []string{"f1"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package audience
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// E.164 numbers have at most 15 digits after the "+"
+const maxPhoneDigits = 15
+
+// sha256Hex returns the lowercase hex SHA-256 digest of s
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// HashEmail normalizes an email address (trimmed, lowercased) and returns its SHA-256 hash
+func HashEmail(email string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(normalized, "@") {
+		return "", errors.New("invalid email address")
+	}
+	return sha256Hex(normalized), nil
+}
+
+// NormalizePhone converts a phone number with country code to E.164 ("+" followed by digits).
+// Spaces, dashes, dots and parentheses are stripped, and a leading "00" international
+// prefix is treated as "+".
+func NormalizePhone(phone string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	if strings.HasPrefix(phone, "00") {
+		phone = "+" + phone[2:]
+	}
+	if !strings.HasPrefix(phone, "+") {
+		return "", errors.New("phone number must include a country code")
+	}
+
+	var digits strings.Builder
+	for _, r := range phone[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+			// formatting characters are dropped
+		default:
+			return "", errors.New("phone number contains invalid characters")
+		}
+	}
+	if digits.Len() == 0 || digits.Len() > maxPhoneDigits {
+		return "", errors.New("phone number must have 1 to 15 digits")
+	}
+	return "+" + digits.String(), nil
+}
+
+// HashPhone normalizes a phone number to E.164 and returns its SHA-256 hash
+func HashPhone(phone string) (string, error) {
+	normalized, err := NormalizePhone(phone)
+	if err != nil {
+		return "", err
+	}
+	return sha256Hex(normalized), nil
+}
+
+// HashDeviceID normalizes a mobile advertising ID (IDFA or GAID; trimmed, lowercased)
+// and returns its SHA-256 hash
+func HashDeviceID(id string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(id))
+	if normalized == "" {
+		return "", errors.New("device ID is required")
+	}
+	return sha256Hex(normalized), nil
+}
@@ -0,0 +1,63 @@
+package audience
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashEmail(t *testing.T) {
+	const want = "973dfe463ec85785f5f95af5ba3906eedb2d931c24e69824a89ea65dba4e813b"
+
+	for _, email := range []string{"test@example.com", "  Test@Example.COM\n"} {
+		got, err := HashEmail(email)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := HashEmail("not-an-email")
+	assert.Error(t, err)
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"+14155552671", "+14155552671"},
+		{"+1 (415) 555-2671", "+14155552671"},
+		{"+1.415.555.2671", "+14155552671"},
+		{"0081 90-1234-5678", "+819012345678"},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizePhone(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+
+	for _, invalid := range []string{"4155552671", "+", "+1 415 555 2671 ext 9", "+1234567890123456"} {
+		_, err := NormalizePhone(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestHashPhone(t *testing.T) {
+	got, err := HashPhone("+1 (415) 555-2671")
+	require.NoError(t, err)
+	assert.Equal(t, "cb6880e416769253645cb9c6b8989154bf66a56a77fc14c81fb1019663cbb928", got)
+
+	got, err = HashPhone("+81 90-1234-5678")
+	require.NoError(t, err)
+	assert.Equal(t, "3d06a39d40790f11761295e053029378ae28c4b4f6f301693005e079f3d4ca64", got)
+}
+
+func TestHashDeviceID(t *testing.T) {
+	got, err := HashDeviceID("6D92078A-8246-4BA4-AE5B-76104861E7DC")
+	require.NoError(t, err)
+	assert.Equal(t, "31b806b4deec8c4cb1ffb18b9ab4ee1fa82a2b30e1f47902f04d03f5db023376", got)
+
+	_, err = HashDeviceID("  ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package audience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// lookalikeRefreshRequest represents the request to refresh lookalike audiences
+type lookalikeRefreshRequest struct {
+	AdvertiserID      string   `json:"advertiser_id"`
+	CustomAudienceIDs []string `json:"custom_audience_ids"`
+}
+
+// RefreshAudienceSize requests a recalculation of a lookalike audience and returns
+// the audience as reported right after the request, usually with status PROCESSING.
+// Poll GetCustomAudiences until GetStatus().IsReady() to read the new size.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1758616888158209
+func (a *API) RefreshAudienceSize(ctx context.Context, advertiserID, audienceID string) (*CustomAudienceInfo, error) {
+	if audienceID == "" {
+		return nil, errors.New("audience ID is required")
+	}
+
+	advertiserID = tiktok.ResolveAdvertiserID(ctx, advertiserID)
+	body := lookalikeRefreshRequest{
+		AdvertiserID:      advertiserID,
+		CustomAudienceIDs: []string{audienceID},
+	}
+
+	var resp struct{}
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/dmp/custom_audience/lookalike/update/", &body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to refresh audience size: %w", err)
+	}
+
+	audiences, err := a.GetCustomAudiences(ctx, &CustomAudienceGetRequest{
+		AdvertiserID:      advertiserID,
+		CustomAudienceIDs: []string{audienceID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(audiences.List) == 0 {
+		return nil, fmt.Errorf("audience %s was refreshed but not found", audienceID)
+	}
+
+	return &audiences.List[0], nil
+}
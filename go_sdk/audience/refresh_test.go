@@ -0,0 +1,59 @@
+package audience
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestRefreshAudienceSize(t *testing.T) {
+	var refreshed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/dmp/custom_audience/lookalike/update/":
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "123456789", body["advertiser_id"])
+			assert.Equal(t, []interface{}{"audience-001"}, body["custom_audience_ids"])
+			refreshed = true
+
+			json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{}`)})
+		case "/open_api/v1.3/dmp/custom_audience/get/":
+			assert.True(t, refreshed, "audience should be fetched after the refresh request")
+			assert.Equal(t, []string{"audience-001"}, r.URL.Query()["custom_audience_ids"])
+
+			json.NewEncoder(w).Encode(tiktok.Response{
+				Code: ptrInt64(0),
+				Data: json.RawMessage(`{"list": [{"custom_audience_id": "audience-001", "audience_type": "LOOKALIKE", "status": "PROCESSING", "size": 120000}]}`),
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	audience, err := api.RefreshAudienceSize(context.Background(), "123456789", "audience-001")
+	require.NoError(t, err)
+	assert.Equal(t, "audience-001", audience.CustomAudienceID)
+	assert.Equal(t, AudienceStatusProcessing, audience.GetStatus())
+	assert.False(t, audience.GetStatus().IsReady())
+	assert.Equal(t, int64(120000), audience.Size)
+}
+
+func TestRefreshAudienceSize_MissingAudienceID(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.RefreshAudienceSize(context.Background(), "123456789", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package audience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// AudienceShareEntry represents one advertiser account an audience has been shared with
+type AudienceShareEntry struct {
+	CustomAudienceID     string `json:"custom_audience_id"`
+	SharedAdvertiserID   string `json:"shared_advertiser_id"`
+	SharedAdvertiserName string `json:"shared_advertiser_name"`
+	Status               string `json:"status"`
+}
+
+// AudienceShareInfoResponse represents the sharing log of an audience
+type AudienceShareInfoResponse struct {
+	List []AudienceShareEntry `json:"list"`
+}
+
+// GetAudienceShareInfo gets the accounts a custom audience has been shared with
+// Reference: https://business-api.tiktok.com/portal/docs?id=1740245827044354
+func (a *API) GetAudienceShareInfo(ctx context.Context, advertiserID, audienceID string) (*AudienceShareInfoResponse, error) {
+	if audienceID == "" {
+		return nil, errors.New("audience ID is required")
+	}
+
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
+	params.Set("custom_audience_id", audienceID)
+
+	var resp AudienceShareInfoResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/dmp/custom_audience/share/log/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get audience share info: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,52 @@
+package audience
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetAudienceShareInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/open_api/v1.3/dmp/custom_audience/share/log/", r.URL.Path)
+		assert.Equal(t, "123456789", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, "audience-001", r.URL.Query().Get("custom_audience_id"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [
+					{"custom_audience_id": "audience-001", "shared_advertiser_id": "adv-2", "shared_advertiser_name": "Brand Two", "status": "SHARED"},
+					{"custom_audience_id": "audience-001", "shared_advertiser_id": "adv-3", "shared_advertiser_name": "Brand Three", "status": "SHARED"}
+				]
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAudienceShareInfo(context.Background(), "123456789", "audience-001")
+	require.NoError(t, err)
+	require.Len(t, resp.List, 2)
+	assert.Equal(t, "adv-2", resp.List[0].SharedAdvertiserID)
+	assert.Equal(t, "Brand Two", resp.List[0].SharedAdvertiserName)
+	assert.Equal(t, "adv-3", resp.List[1].SharedAdvertiserID)
+	assert.Equal(t, "SHARED", resp.List[1].Status)
+}
+
+func TestGetAudienceShareInfo_MissingAudienceID(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.GetAudienceShareInfo(context.Background(), "123456789", "")
+	assert.Error(t, err)
+}
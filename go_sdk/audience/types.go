@@ -0,0 +1,127 @@
+package audience
+
+import "fmt"
+
+// AudienceType represents the source of a custom audience
+type AudienceType string
+
+// Audience types
+const (
+	AudienceTypePixel          AudienceType = "PIXEL"
+	AudienceTypeFile           AudienceType = "FILE"
+	AudienceTypeLookalike      AudienceType = "LOOKALIKE"
+	AudienceTypeEngagement     AudienceType = "ENGAGEMENT"
+	AudienceTypeApp            AudienceType = "APP"
+	AudienceTypeLeadGeneration AudienceType = "LEAD_GENERATION"
+)
+
+var audienceTypes = map[AudienceType]bool{
+	AudienceTypePixel:          true,
+	AudienceTypeFile:           true,
+	AudienceTypeLookalike:      true,
+	AudienceTypeEngagement:     true,
+	AudienceTypeApp:            true,
+	AudienceTypeLeadGeneration: true,
+}
+
+// ParseAudienceType converts a raw audience_type value into an AudienceType
+func ParseAudienceType(s string) (AudienceType, error) {
+	t := AudienceType(s)
+	if !t.IsValid() {
+		return "", fmt.Errorf("unknown audience type %q", s)
+	}
+	return t, nil
+}
+
+// IsValid reports whether the type is a known audience type
+func (t AudienceType) IsValid() bool {
+	return audienceTypes[t]
+}
+
+// IsLookalike reports whether the audience is a lookalike audience
+func (t AudienceType) IsLookalike() bool {
+	return t == AudienceTypeLookalike
+}
+
+// AudienceStatus represents the processing status of a custom audience
+type AudienceStatus string
+
+// Audience statuses
+const (
+	AudienceStatusActive     AudienceStatus = "ACTIVE"
+	AudienceStatusProcessing AudienceStatus = "PROCESSING"
+	AudienceStatusInactive   AudienceStatus = "INACTIVE"
+	AudienceStatusExpired    AudienceStatus = "EXPIRED"
+)
+
+var audienceStatuses = map[AudienceStatus]bool{
+	AudienceStatusActive:     true,
+	AudienceStatusProcessing: true,
+	AudienceStatusInactive:   true,
+	AudienceStatusExpired:    true,
+}
+
+// ParseAudienceStatus converts a raw status value into an AudienceStatus
+func ParseAudienceStatus(s string) (AudienceStatus, error) {
+	st := AudienceStatus(s)
+	if !st.IsValid() {
+		return "", fmt.Errorf("unknown audience status %q", s)
+	}
+	return st, nil
+}
+
+// IsValid reports whether the status is a known audience status
+func (s AudienceStatus) IsValid() bool {
+	return audienceStatuses[s]
+}
+
+// IsReady reports whether the audience can be used for targeting
+func (s AudienceStatus) IsReady() bool {
+	return s == AudienceStatusActive
+}
+
+// GetAudienceType returns the audience's type as a typed value
+func (c *CustomAudienceInfo) GetAudienceType() AudienceType {
+	return AudienceType(c.AudienceType)
+}
+
+// GetStatus returns the audience's status as a typed value
+func (c *CustomAudienceInfo) GetStatus() AudienceStatus {
+	return AudienceStatus(c.Status)
+}
+
+// FilterByType returns the audiences whose type is one of types
+func FilterByType(audiences []CustomAudienceInfo, types ...AudienceType) []CustomAudienceInfo {
+	var out []CustomAudienceInfo
+	for _, a := range audiences {
+		for _, t := range types {
+			if a.GetAudienceType() == t {
+				out = append(out, a)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// ReadyAudiences returns the audiences that can be used for targeting
+func ReadyAudiences(audiences []CustomAudienceInfo) []CustomAudienceInfo {
+	var out []CustomAudienceInfo
+	for _, a := range audiences {
+		if a.GetStatus().IsReady() {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// LookalikeAudiences returns the lookalike audiences
+func LookalikeAudiences(audiences []CustomAudienceInfo) []CustomAudienceInfo {
+	var out []CustomAudienceInfo
+	for _, a := range audiences {
+		if a.GetAudienceType().IsLookalike() {
+			out = append(out, a)
+		}
+	}
+	return out
+}
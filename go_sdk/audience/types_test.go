@@ -0,0 +1,83 @@
+package audience
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAudienceType(t *testing.T) {
+	tests := []struct {
+		raw       string
+		want      AudienceType
+		lookalike bool
+	}{
+		{"PIXEL", AudienceTypePixel, false},
+		{"FILE", AudienceTypeFile, false},
+		{"LOOKALIKE", AudienceTypeLookalike, true},
+		{"ENGAGEMENT", AudienceTypeEngagement, false},
+		{"APP", AudienceTypeApp, false},
+		{"LEAD_GENERATION", AudienceTypeLeadGeneration, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := ParseAudienceType(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.lookalike, got.IsLookalike())
+		})
+	}
+
+	_, err := ParseAudienceType("UNKNOWN")
+	assert.Error(t, err)
+	assert.False(t, AudienceType("").IsValid())
+}
+
+func TestParseAudienceStatus(t *testing.T) {
+	tests := []struct {
+		raw   string
+		want  AudienceStatus
+		ready bool
+	}{
+		{"ACTIVE", AudienceStatusActive, true},
+		{"PROCESSING", AudienceStatusProcessing, false},
+		{"INACTIVE", AudienceStatusInactive, false},
+		{"EXPIRED", AudienceStatusExpired, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := ParseAudienceStatus(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.ready, got.IsReady())
+		})
+	}
+
+	_, err := ParseAudienceStatus("active")
+	assert.Error(t, err)
+}
+
+func TestAudienceFilters(t *testing.T) {
+	audiences := []CustomAudienceInfo{
+		{CustomAudienceID: "a1", AudienceType: "PIXEL", Status: "ACTIVE"},
+		{CustomAudienceID: "a2", AudienceType: "LOOKALIKE", Status: "PROCESSING"},
+		{CustomAudienceID: "a3", AudienceType: "FILE", Status: "ACTIVE"},
+		{CustomAudienceID: "a4", AudienceType: "LOOKALIKE", Status: "ACTIVE"},
+	}
+
+	ids := func(list []CustomAudienceInfo) []string {
+		var out []string
+		for _, a := range list {
+			out = append(out, a.CustomAudienceID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"a1", "a3"}, ids(FilterByType(audiences, AudienceTypePixel, AudienceTypeFile)))
+	assert.Equal(t, []string{"a1", "a3", "a4"}, ids(ReadyAudiences(audiences)))
+	assert.Equal(t, []string{"a2", "a4"}, ids(LookalikeAudiences(audiences)))
+	assert.Empty(t, FilterByType(audiences))
+}
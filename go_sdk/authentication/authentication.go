@@ -22,19 +22,15 @@ type API struct {
 // Note: Authentication endpoints don't require an access token in the client
 func NewAPI() *API {
 	return &API{
-		baseURL: "https://business-api.tiktok.com",
-		httpClient: &http.Client{
-			Timeout: 30 * 1000000000, // 30 seconds
-		},
+		baseURL:    "https://business-api.tiktok.com",
+		httpClient: tiktok.NewDefaultHTTPClient(),
 	}
 }
 
 // NewAPIWithConfig creates a new Authentication API client with custom configuration
 func NewAPIWithConfig(baseURL string, httpClient *http.Client) *API {
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: 30 * 1000000000, // 30 seconds
-		}
+		httpClient = tiktok.NewDefaultHTTPClient()
 	}
 	if baseURL == "" {
 		baseURL = "https://business-api.tiktok.com"
@@ -64,12 +60,34 @@ type AccessTokenResponse struct {
 	Scope                 string   `json:"scope,omitempty"`
 }
 
+// AllAdvertiserIDs returns AdvertiserIDs and AdvertiserID merged into one slice without duplicates
+func (r *AccessTokenResponse) AllAdvertiserIDs() []string {
+	candidates := make([]string, 0, len(r.AdvertiserIDs)+1)
+	candidates = append(candidates, r.AdvertiserIDs...)
+	candidates = append(candidates, r.AdvertiserID)
+
+	ids := make([]string, 0, len(candidates))
+	seen := make(map[string]bool, len(candidates))
+	for _, id := range candidates {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 // GetAccessToken gets access_token and refresh_token by auth_code.
 // The creator access token is valid for 24 hours and the refresh token is valid for one year.
 // Within one year you will need to refresh the access token with the refresh token on a daily basis.
 // After one year you will need to ask the creator to reauthorize.
 // Reference: https://ads.tiktok.com/marketing_api/docs?id=1739965703387137
 func (a *API) GetAccessToken(ctx context.Context, req *AccessTokenRequest) (*AccessTokenResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	// Build URL
 	fullURL := a.baseURL + "/open_api/v1.3/oauth2/access_token/"
 
@@ -150,6 +168,10 @@ type RefreshTokenRequest struct {
 // when the current one expires (after 24 hours).
 // Reference: https://ads.tiktok.com/marketing_api/docs?id=1739965703387137
 func (a *API) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*AccessTokenResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	// Build URL
 	fullURL := a.baseURL + "/open_api/v1.3/oauth2/access_token/"
 
@@ -294,3 +294,43 @@ func TestNewAPIWithConfig(t *testing.T) {
 	assert.Equal(t, "https://business-api.tiktok.com", api.baseURL)
 	assert.NotNil(t, api.httpClient)
 }
+
+func TestAccessTokenResponse_AllAdvertiserIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		resp AccessTokenResponse
+		want []string
+	}{
+		{
+			name: "singular only",
+			resp: AccessTokenResponse{AdvertiserID: "adv1"},
+			want: []string{"adv1"},
+		},
+		{
+			name: "plural only",
+			resp: AccessTokenResponse{AdvertiserIDs: []string{"adv1", "adv2"}},
+			want: []string{"adv1", "adv2"},
+		},
+		{
+			name: "both with overlap",
+			resp: AccessTokenResponse{AdvertiserIDs: []string{"adv1", "adv2"}, AdvertiserID: "adv2"},
+			want: []string{"adv1", "adv2"},
+		},
+		{
+			name: "both distinct",
+			resp: AccessTokenResponse{AdvertiserIDs: []string{"adv1"}, AdvertiserID: "adv3"},
+			want: []string{"adv1", "adv3"},
+		},
+		{
+			name: "neither",
+			resp: AccessTokenResponse{},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resp.AllAdvertiserIDs())
+		})
+	}
+}
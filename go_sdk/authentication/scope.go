@@ -0,0 +1,44 @@
+package authentication
+
+import "strings"
+
+// Scope is a single permission granted to an access token
+type Scope string
+
+// Scopes is the set of permissions granted to an access token
+type Scopes []Scope
+
+// ParseScopes splits a scope string delimited by commas and/or whitespace into typed scopes.
+// Empty entries are skipped and duplicates are kept only once.
+func ParseScopes(s string) Scopes {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	scopes := make(Scopes, 0, len(fields))
+	seen := make(map[Scope]bool, len(fields))
+	for _, f := range fields {
+		scope := Scope(f)
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+// Has reports whether scope was granted
+func (s Scopes) Has(scope Scope) bool {
+	for _, granted := range s {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Scopes returns the granted permissions parsed from the scope field
+func (r *AccessTokenResponse) Scopes() Scopes {
+	return ParseScopes(r.Scope)
+}
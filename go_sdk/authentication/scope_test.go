@@ -0,0 +1,37 @@
+package authentication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScopes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Scopes
+	}{
+		{"comma delimited", "user.info.basic,video.list", Scopes{"user.info.basic", "video.list"}},
+		{"space delimited", "user.info.basic video.list", Scopes{"user.info.basic", "video.list"}},
+		{"mixed delimiters", " user.info.basic, video.list\tbiz.ads ", Scopes{"user.info.basic", "video.list", "biz.ads"}},
+		{"duplicates", "video.list,video.list", Scopes{"video.list"}},
+		{"empty", "", Scopes{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseScopes(tt.input))
+		})
+	}
+}
+
+func TestScopes_Has(t *testing.T) {
+	resp := &AccessTokenResponse{Scope: "user.info.basic, video.list"}
+	scopes := resp.Scopes()
+
+	assert.True(t, scopes.Has("user.info.basic"))
+	assert.True(t, scopes.Has("video.list"))
+	assert.False(t, scopes.Has("video.upload"))
+	assert.False(t, Scopes(nil).Has("video.list"))
+}
@@ -0,0 +1,106 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before expiry a token is treated as expired
+const tokenExpiryMargin = time.Minute
+
+// TokenSource returns a valid access token, refreshing it with the refresh token when it expires.
+// It is safe for concurrent use: when the token expires, one caller refreshes it
+// and the others wait for that result.
+type TokenSource struct {
+	api    *API
+	appID  string
+	secret string
+	now    func() time.Time
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+	inflight     *refreshCall
+}
+
+// refreshCall tracks a refresh in progress
+type refreshCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// NewTokenSource creates a TokenSource from a token obtained with GetAccessToken or RefreshToken.
+// The access token is considered valid for token.ExpiresIn seconds from now.
+func NewTokenSource(api *API, appID, secret string, token *AccessTokenResponse) *TokenSource {
+	s := &TokenSource{
+		api:    api,
+		appID:  appID,
+		secret: secret,
+		now:    time.Now,
+	}
+	if token != nil {
+		s.setToken(token)
+	}
+	return s
+}
+
+// setToken stores a token response; the caller must hold s.mu
+func (s *TokenSource) setToken(token *AccessTokenResponse) {
+	s.accessToken = token.AccessToken
+	if token.RefreshToken != "" {
+		s.refreshToken = token.RefreshToken
+	}
+	s.expiry = s.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+}
+
+// valid reports whether the cached access token can be used; the caller must hold s.mu
+func (s *TokenSource) valid() bool {
+	return s.accessToken != "" && s.now().Add(tokenExpiryMargin).Before(s.expiry)
+}
+
+// Token returns a valid access token, refreshing it if it has expired
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	if s.valid() {
+		token := s.accessToken
+		s.mu.Unlock()
+		return token, nil
+	}
+	if call := s.inflight; call != nil {
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.token, call.err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	call := &refreshCall{done: make(chan struct{})}
+	s.inflight = call
+	refreshToken := s.refreshToken
+	s.mu.Unlock()
+
+	resp, err := s.api.RefreshToken(ctx, &RefreshTokenRequest{
+		AppID:        s.appID,
+		Secret:       s.secret,
+		RefreshToken: refreshToken,
+	})
+
+	s.mu.Lock()
+	if err != nil {
+		call.err = fmt.Errorf("failed to refresh access token: %w", err)
+	} else {
+		s.setToken(resp)
+		call.token = resp.AccessToken
+	}
+	s.inflight = nil
+	s.mu.Unlock()
+	close(call.done)
+
+	return call.token, call.err
+}
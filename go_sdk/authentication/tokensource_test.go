@@ -0,0 +1,116 @@
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRefreshServer(t *testing.T, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+
+		var reqBody map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+		assert.Equal(t, "refresh_token", reqBody["grant_type"])
+		assert.Equal(t, "old_refresh_token", reqBody["refresh_token"])
+
+		// Keep the refresh in flight long enough for every caller to arrive
+		time.Sleep(50 * time.Millisecond)
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"access_token":  "new_access_token",
+				"refresh_token": "new_refresh_token",
+				"expires_in":    86400,
+			},
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestTokenSource_ConcurrentRefresh(t *testing.T) {
+	var calls int32
+	server := newRefreshServer(t, &calls)
+	defer server.Close()
+
+	source := NewTokenSource(NewAPIWithConfig(server.URL, nil), "app", "secret", &AccessTokenResponse{
+		AccessToken:  "expired_token",
+		RefreshToken: "old_refresh_token",
+		ExpiresIn:    0,
+	})
+
+	const callers = 20
+	var wg sync.WaitGroup
+	tokens := make([]string, callers)
+	errs := make([]error, callers)
+	start := make(chan struct{})
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			tokens[i], errs[i] = source.Token(context.Background())
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "new_access_token", tokens[i])
+	}
+
+	// The refreshed token is cached
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "new_access_token", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestTokenSource_ValidTokenIsNotRefreshed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	source := NewTokenSource(NewAPIWithConfig(server.URL, nil), "app", "secret", &AccessTokenResponse{
+		AccessToken:  "valid_token",
+		RefreshToken: "old_refresh_token",
+		ExpiresIn:    86400,
+	})
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "valid_token", token)
+}
+
+func TestTokenSource_RefreshError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := map[string]interface{}{
+			"code":    40105,
+			"message": "Refresh token expired",
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	source := NewTokenSource(NewAPIWithConfig(server.URL, nil), "app", "secret", &AccessTokenResponse{
+		RefreshToken: "old_refresh_token",
+	})
+
+	_, err := source.Token(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Refresh token expired")
+}
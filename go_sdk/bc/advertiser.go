@@ -0,0 +1,91 @@
+package bc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// AdvertiserCreateInfo represents the basic settings of a new ad account
+type AdvertiserCreateInfo struct {
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+	Timezone string `json:"timezone"`
+}
+
+// CustomerInfo represents the company that owns a new ad account
+type CustomerInfo struct {
+	Company        string `json:"company"`
+	Industry       string `json:"industry"`
+	RegisteredArea string `json:"registered_area"`
+	Website        string `json:"website,omitempty"`
+}
+
+// ContactInfo represents the contact person of a new ad account
+type ContactInfo struct {
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Number string `json:"number,omitempty"`
+}
+
+// CreateAdvertiserRequest represents the request to create an ad account under a BC
+type CreateAdvertiserRequest struct {
+	BcID           string               `json:"bc_id"`
+	AdvertiserInfo AdvertiserCreateInfo `json:"advertiser_info"`
+	CustomerInfo   CustomerInfo         `json:"customer_info"`
+	ContactInfo    *ContactInfo         `json:"contact_info,omitempty"`
+}
+
+// Validate checks that the fields required by the API are set
+func (r *CreateAdvertiserRequest) Validate() error {
+	var missing []string
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"bc_id", r.BcID},
+		{"advertiser_info.name", r.AdvertiserInfo.Name},
+		{"advertiser_info.currency", r.AdvertiserInfo.Currency},
+		{"advertiser_info.timezone", r.AdvertiserInfo.Timezone},
+		{"customer_info.company", r.CustomerInfo.Company},
+		{"customer_info.industry", r.CustomerInfo.Industry},
+		{"customer_info.registered_area", r.CustomerInfo.RegisteredArea},
+	} {
+		if f.value == "" {
+			missing = append(missing, f.name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %v", missing)
+	}
+	if r.ContactInfo != nil && r.ContactInfo.Email == "" && r.ContactInfo.Number == "" {
+		return errors.New("contact info needs an email or a phone number")
+	}
+	return nil
+}
+
+// CreateAdvertiserResponse represents the response from creating an ad account
+type CreateAdvertiserResponse struct {
+	AdvertiserID string `json:"advertiser_id"`
+}
+
+// CreateAdvertiser creates a new ad account (sub-account) under a Business Center
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739939020318721
+func (a *API) CreateAdvertiser(ctx context.Context, req *CreateAdvertiserRequest) (*CreateAdvertiserResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp CreateAdvertiserResponse
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/bc/advertiser/create/", req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to create advertiser: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,102 @@
+package bc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func validCreateAdvertiserRequest() *CreateAdvertiserRequest {
+	return &CreateAdvertiserRequest{
+		BcID: "bc-123",
+		AdvertiserInfo: AdvertiserCreateInfo{
+			Name:     "New Brand JP",
+			Currency: "JPY",
+			Timezone: "Asia/Tokyo",
+		},
+		CustomerInfo: CustomerInfo{
+			Company:        "New Brand Inc.",
+			Industry:       "290100",
+			RegisteredArea: "JP",
+		},
+		ContactInfo: &ContactInfo{
+			Name:  "Taro",
+			Email: "ads@example.com",
+		},
+	}
+}
+
+func TestCreateAdvertiser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/bc/advertiser/create/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "bc-123", body["bc_id"])
+		assert.Equal(t, map[string]interface{}{
+			"name":     "New Brand JP",
+			"currency": "JPY",
+			"timezone": "Asia/Tokyo",
+		}, body["advertiser_info"])
+		assert.Equal(t, map[string]interface{}{
+			"company":         "New Brand Inc.",
+			"industry":        "290100",
+			"registered_area": "JP",
+		}, body["customer_info"])
+		assert.Equal(t, map[string]interface{}{
+			"name":  "Taro",
+			"email": "ads@example.com",
+		}, body["contact_info"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"advertiser_id":"7300000000000000001"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.CreateAdvertiser(context.Background(), validCreateAdvertiserRequest())
+	require.NoError(t, err)
+	assert.Equal(t, "7300000000000000001", resp.AdvertiserID)
+}
+
+func TestCreateAdvertiserRequest_Validate(t *testing.T) {
+	require.NoError(t, validCreateAdvertiserRequest().Validate())
+
+	req := validCreateAdvertiserRequest()
+	req.AdvertiserInfo.Currency = ""
+	req.CustomerInfo.RegisteredArea = ""
+	err := req.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "advertiser_info.currency")
+	assert.Contains(t, err.Error(), "customer_info.registered_area")
+
+	req = validCreateAdvertiserRequest()
+	req.ContactInfo = &ContactInfo{Name: "Taro"}
+	assert.Error(t, req.Validate())
+
+	req = validCreateAdvertiserRequest()
+	req.ContactInfo = nil
+	assert.NoError(t, req.Validate())
+}
+
+func TestCreateAdvertiser_InvalidRequest(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.CreateAdvertiser(context.Background(), &CreateAdvertiserRequest{BcID: "bc-123"})
+	assert.Error(t, err)
+
+	_, err = api.CreateAdvertiser(context.Background(), nil)
+	assert.ErrorIs(t, err, tiktok.ErrNilRequest)
+}
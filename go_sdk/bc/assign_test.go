@@ -0,0 +1,116 @@
+package bc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestAssignAsset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/bc/asset/assign/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"bc_id":           "bc-123",
+			"user_id":         "user-456",
+			"asset_type":      "ADVERTISER",
+			"asset_id":        "adv-789",
+			"advertiser_role": "OPERATOR",
+		}, body)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	role := "OPERATOR"
+	err := api.AssignAsset(context.Background(), &AssetAssignRequest{
+		BcID:           "bc-123",
+		UserID:         "user-456",
+		AssetType:      AssetTypeAdvertiser,
+		AssetID:        "adv-789",
+		AdvertiserRole: &role,
+	})
+	require.NoError(t, err)
+}
+
+func TestAssignAsset_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := tiktok.Response{
+			Code:    ptrInt64(40001),
+			Message: ptrString("No permission to operate this asset"),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.AssignAsset(context.Background(), &AssetAssignRequest{
+		BcID:      "bc-123",
+		UserID:    "user-456",
+		AssetType: AssetTypePixel,
+		AssetID:   "pixel-1",
+	})
+	require.Error(t, err)
+
+	var apiErr *tiktok.ErrorResponse
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, int64(40001), apiErr.Code)
+}
+
+func TestUnassignAsset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/bc/asset/unassign/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"bc_id":      "bc-123",
+			"user_id":    "user-456",
+			"asset_type": "CATALOG",
+			"asset_id":   "catalog-1",
+		}, body)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.UnassignAsset(context.Background(), &AssetUnassignRequest{
+		BcID:      "bc-123",
+		UserID:    "user-456",
+		AssetType: AssetTypeCatalog,
+		AssetID:   "catalog-1",
+	})
+	require.NoError(t, err)
+}
+
+func TestAssetAssignRequest_Validate(t *testing.T) {
+	assert.Error(t, (&AssetAssignRequest{BcID: "bc", UserID: "u", AssetType: "VIDEO", AssetID: "a"}).Validate())
+	assert.Error(t, (&AssetAssignRequest{BcID: "bc", AssetType: AssetTypePixel, AssetID: "a"}).Validate())
+	assert.NoError(t, (&AssetAssignRequest{BcID: "bc", UserID: "u", AssetType: AssetTypePixel, AssetID: "a"}).Validate())
+}
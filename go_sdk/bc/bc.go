@@ -24,15 +24,15 @@ func NewAPI(client *tiktok.Client) *API {
 
 // TransactionInfo represents transaction information
 type TransactionInfo struct {
-	TransactionID   string  `json:"transaction_id"`
-	TransactionTime string  `json:"transaction_time"`
-	TransactionType string  `json:"transaction_type"`
-	Amount          float64 `json:"amount"`
-	Currency        string  `json:"currency"`
-	AdvertiserID    string  `json:"advertiser_id,omitempty"`
-	AdvertiserName  string  `json:"advertiser_name,omitempty"`
-	Description     string  `json:"description,omitempty"`
-	Balance         float64 `json:"balance,omitempty"`
+	TransactionID   string             `json:"transaction_id"`
+	TransactionTime string             `json:"transaction_time"`
+	TransactionType string             `json:"transaction_type"`
+	Amount          tiktok.FlexFloat64 `json:"amount"`
+	Currency        string             `json:"currency"`
+	AdvertiserID    string             `json:"advertiser_id,omitempty"`
+	AdvertiserName  string             `json:"advertiser_name,omitempty"`
+	Description     string             `json:"description,omitempty"`
+	Balance         tiktok.FlexFloat64 `json:"balance,omitempty"`
 }
 
 // AccountTransactionResponse represents the response for account transactions
@@ -41,13 +41,12 @@ type AccountTransactionResponse struct {
 	PageInfo PageInfo          `json:"page_info"`
 }
 
-// PageInfo represents pagination information
-type PageInfo struct {
-	Page        int64 `json:"page"`
-	PageSize    int64 `json:"page_size"`
-	TotalNumber int64 `json:"total_number"`
-	TotalPage   int64 `json:"total_page"`
-}
+// PageInfo represents pagination information; it is the same type as tiktok.PageInfo
+type PageInfo = tiktok.PageInfo
+
+// DefaultMaxTransactions is the number of transactions GetAllAccountTransactions
+// fetches at most when AccountTransactionRequest.MaxItems is not set
+const DefaultMaxTransactions = 10000
 
 // AccountTransactionRequest represents the request to get account transactions
 type AccountTransactionRequest struct {
@@ -57,11 +56,18 @@ type AccountTransactionRequest struct {
 	Filtering        interface{} `json:"filtering,omitempty"`
 	Page             *int64      `json:"page,omitempty"`
 	PageSize         *int64      `json:"page_size,omitempty"`
+	// MaxItems caps GetAllAccountTransactions, which fails instead of fetching more
+	// (DefaultMaxTransactions if 0)
+	MaxItems int `json:"-"`
 }
 
 // GetAccountTransactions gets the transaction records of a BC or ad accounts
 // Reference: https://business-api.tiktok.com/portal/docs?id=1792849810925569
 func (a *API) GetAccountTransactions(ctx context.Context, req *AccountTransactionRequest) (*AccountTransactionResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
 
 	if req.BcID != nil {
@@ -105,6 +111,32 @@ func (a *API) GetAccountTransactions(ctx context.Context, req *AccountTransactio
 	return &transResp, nil
 }
 
+// GetAllAccountTransactions retrieves all transaction records by automatically handling pagination.
+// It fails with tiktok.ErrTooManyItems when the history holds more than req.MaxItems
+// transactions; narrow the filtering (e.g. by date) to fetch it in parts.
+func (a *API) GetAllAccountTransactions(ctx context.Context, req *AccountTransactionRequest) ([]TransactionInfo, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	maxItems := req.MaxItems
+	if maxItems <= 0 {
+		maxItems = DefaultMaxTransactions
+	}
+
+	pageReq := *req
+	return tiktok.PaginateLimit(ctx, tiktok.MaxPageSize, maxItems, func(ctx context.Context, page, pageSize int64) ([]TransactionInfo, tiktok.PageInfo, error) {
+		pageReq.Page = &page
+		pageReq.PageSize = &pageSize
+
+		resp, err := a.GetAccountTransactions(ctx, &pageReq)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to get account transactions page %d: %w", page, err)
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+}
+
 // AssetInfo represents asset information
 type AssetInfo struct {
 	AssetID   string `json:"asset_id"`
@@ -130,6 +162,10 @@ type AssetGetRequest struct {
 // GetAssets gets assets in a Business Center
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739593603696641
 func (a *API) GetAssets(ctx context.Context, req *AssetGetRequest) (*AssetGetResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
 	params.Set("bc_id", req.BcID)
 	params.Set("asset_type", req.AssetType)
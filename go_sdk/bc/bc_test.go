@@ -83,7 +83,7 @@ func TestGetAccountTransactions_Success(t *testing.T) {
 	assert.Len(t, result.List, 2)
 	assert.Equal(t, "trans-001", result.List[0].TransactionID)
 	assert.Equal(t, "DEPOSIT", result.List[0].TransactionType)
-	assert.Equal(t, 1000.50, result.List[0].Amount)
+	assert.Equal(t, 1000.50, result.List[0].Amount.Float64())
 	assert.Equal(t, "USD", result.List[0].Currency)
 	assert.Equal(t, int64(1), result.PageInfo.Page)
 	assert.Equal(t, int64(2), result.PageInfo.TotalNumber)
@@ -419,6 +419,62 @@ func TestGetAssets_EmptyResult(t *testing.T) {
 	assert.Equal(t, int64(0), result.PageInfo.TotalNumber)
 }
 
+func TestGetAllAccountTransactions(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "bc-123", r.URL.Query().Get("bc_id"))
+		assert.Equal(t, "100", r.URL.Query().Get("page_size"))
+
+		var data string
+		switch r.URL.Query().Get("page") {
+		case "1":
+			data = `{"list":[{"transaction_id":"trans-001"},{"transaction_id":"trans-002"}],"page_info":{"page":1,"page_size":100,"total_number":3,"total_page":2}}`
+		case "2":
+			data = `{"list":[{"transaction_id":"trans-003"}],"page_info":{"page":2,"page_size":100,"total_number":3,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	bcID := "bc-123"
+	req := &AccountTransactionRequest{BcID: &bcID}
+	result, err := api.GetAllAccountTransactions(context.Background(), req)
+	require.NoError(t, err)
+
+	ids := make([]string, len(result))
+	for i, tr := range result {
+		ids[i] = tr.TransactionID
+	}
+	assert.Equal(t, []string{"trans-001", "trans-002", "trans-003"}, ids)
+	assert.Equal(t, 2, calls)
+	assert.Nil(t, req.Page, "the caller's request should not be modified")
+}
+
+func TestGetAllAccountTransactions_MaxItems(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		data := `{"list":[{"transaction_id":"trans-001"}],"page_info":{"page":1,"page_size":100,"total_number":50000,"total_page":500}}`
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	bcID := "bc-123"
+	_, err := api.GetAllAccountTransactions(context.Background(), &AccountTransactionRequest{BcID: &bcID, MaxItems: 1000})
+	assert.ErrorIs(t, err, tiktok.ErrTooManyItems)
+	assert.Equal(t, 1, calls)
+}
+
 // Helper functions
 func ptrInt64(i int64) *int64 {
 	return &i
@@ -0,0 +1,57 @@
+package bc
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Transaction types for TransactionFiltering
+const (
+	TransactionTypeDeposit = "DEPOSIT"
+	TransactionTypeCharge  = "CHARGE"
+	TransactionTypeRefund  = "REFUND"
+)
+
+// TransactionFiltering is a typed filter that can be assigned to AccountTransactionRequest.Filtering.
+// StartTime and EndTime use the "YYYY-MM-DD HH:MM:SS" format.
+type TransactionFiltering struct {
+	TransactionTypes []string `json:"transaction_types,omitempty"`
+	StartTime        *string  `json:"start_time,omitempty"`
+	EndTime          *string  `json:"end_time,omitempty"`
+}
+
+// zeroDecimalCurrencies are the currencies without minor units
+var zeroDecimalCurrencies = map[string]bool{
+	"CLP": true, "ISK": true, "JPY": true, "KRW": true, "PYG": true,
+	"UGX": true, "VND": true, "XAF": true, "XOF": true,
+}
+
+// Money is an amount in a currency
+type Money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// String formats the amount with the number of decimals of its currency, e.g.
+// "1000.50 USD" or "1500 JPY"
+func (m Money) String() string {
+	decimals := 2
+	if zeroDecimalCurrencies[strings.ToUpper(m.Currency)] {
+		decimals = 0
+	}
+	formatted := strconv.FormatFloat(m.Amount, 'f', decimals, 64)
+	if m.Currency == "" {
+		return formatted
+	}
+	return formatted + " " + m.Currency
+}
+
+// Money returns the transaction amount in the transaction currency
+func (t TransactionInfo) Money() Money {
+	return Money{Amount: t.Amount.Float64(), Currency: t.Currency}
+}
+
+// BalanceMoney returns the balance after the transaction in the transaction currency
+func (t TransactionInfo) BalanceMoney() Money {
+	return Money{Amount: t.Balance.Float64(), Currency: t.Currency}
+}
@@ -0,0 +1,75 @@
+package bc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetAccountTransactions_TypedFiltering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.JSONEq(t, `{
+			"transaction_types": ["DEPOSIT", "REFUND"],
+			"start_time": "2024-01-01 00:00:00",
+			"end_time": "2024-12-31 23:59:59"
+		}`, r.URL.Query().Get("filtering"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	bcID := "bc-filter"
+	startTime := "2024-01-01 00:00:00"
+	endTime := "2024-12-31 23:59:59"
+	_, err := api.GetAccountTransactions(context.Background(), &AccountTransactionRequest{
+		BcID: &bcID,
+		Filtering: &TransactionFiltering{
+			TransactionTypes: []string{TransactionTypeDeposit, TransactionTypeRefund},
+			StartTime:        &startTime,
+			EndTime:          &endTime,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestTransactionFiltering_OmitsEmptyFields(t *testing.T) {
+	data, err := json.Marshal(TransactionFiltering{TransactionTypes: []string{TransactionTypeCharge}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"transaction_types": ["CHARGE"]}`, string(data))
+}
+
+func TestTransactionInfo_StringAmounts(t *testing.T) {
+	var info TransactionInfo
+	err := json.Unmarshal([]byte(`{
+		"transaction_id": "trans-001",
+		"transaction_type": "DEPOSIT",
+		"amount": "1000.50",
+		"balance": "2500",
+		"currency": "USD"
+	}`), &info)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1000.50, info.Amount.Float64())
+	assert.Equal(t, 2500.0, info.Balance.Float64())
+	assert.Equal(t, "1000.50 USD", info.Money().String())
+	assert.Equal(t, "2500.00 USD", info.BalanceMoney().String())
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "1500 JPY", Money{Amount: 1500, Currency: "JPY"}.String())
+	assert.Equal(t, "-50.25 EUR", Money{Amount: -50.25, Currency: "EUR"}.String())
+	assert.Equal(t, "12.30", Money{Amount: 12.3}.String())
+}
@@ -0,0 +1,26 @@
+package tiktok
+
+// BudgetMode represents the budget mode of a campaign or ad group
+type BudgetMode string
+
+// Budget modes
+const (
+	BudgetModeInfinite BudgetMode = "BUDGET_MODE_INFINITE"
+	BudgetModeDay      BudgetMode = "BUDGET_MODE_DAY"
+	BudgetModeTotal    BudgetMode = "BUDGET_MODE_TOTAL"
+)
+
+// IsInfinite reports whether the budget is unlimited
+func (m BudgetMode) IsInfinite() bool {
+	return m == BudgetModeInfinite
+}
+
+// IsDaily reports whether the budget is a daily budget
+func (m BudgetMode) IsDaily() bool {
+	return m == BudgetModeDay
+}
+
+// IsTotal reports whether the budget is a lifetime budget
+func (m BudgetMode) IsTotal() bool {
+	return m == BudgetModeTotal
+}
@@ -0,0 +1,31 @@
+package tiktok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetMode(t *testing.T) {
+	tests := []struct {
+		raw      string
+		infinite bool
+		daily    bool
+		total    bool
+	}{
+		{raw: "BUDGET_MODE_INFINITE", infinite: true},
+		{raw: "BUDGET_MODE_DAY", daily: true},
+		{raw: "BUDGET_MODE_TOTAL", total: true},
+		{raw: "BUDGET_MODE_DYNAMIC_DAILY_BUDGET"},
+		{raw: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			m := BudgetMode(tt.raw)
+			assert.Equal(t, tt.infinite, m.IsInfinite())
+			assert.Equal(t, tt.daily, m.IsDaily())
+			assert.Equal(t, tt.total, m.IsTotal())
+		})
+	}
+}
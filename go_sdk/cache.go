@@ -0,0 +1,128 @@
+package tiktok
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+)
+
+// DefaultResponseCacheSize is the number of responses WithResponseCache keeps
+const DefaultResponseCacheSize = 1000
+
+// cachedResponse is a GET response body stored with its validators
+type cachedResponse struct {
+	url          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// responseCache stores successful GET responses by URL so they can be revalidated
+// with conditional requests instead of downloaded again. It holds at most maxEntries
+// responses and evicts the least recently used one when full.
+type responseCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List // front is most recently used; values are *cachedResponse
+}
+
+// WithResponseCache caches GET responses that carry an ETag or Last-Modified header.
+// Later requests for the same URL send If-None-Match/If-Modified-Since, and a
+// 304 Not Modified reuses the cached body. Responses without validators are not cached.
+// At most DefaultResponseCacheSize responses are kept; see WithResponseCacheSize.
+func WithResponseCache() ClientOption {
+	return WithResponseCacheSize(DefaultResponseCacheSize)
+}
+
+// WithResponseCacheSize is WithResponseCache keeping at most maxEntries responses,
+// evicting the least recently used. A maxEntries of 0 or less disables the cache.
+func WithResponseCacheSize(maxEntries int) ClientOption {
+	return func(c *Client) {
+		if maxEntries <= 0 {
+			c.responseCache = nil
+			return
+		}
+		c.responseCache = &responseCache{
+			maxEntries: maxEntries,
+			entries:    make(map[string]*list.Element),
+			lru:        list.New(),
+		}
+	}
+}
+
+// get returns the cached response for url and marks it as recently used.
+// The caller must hold rc.mu.
+func (rc *responseCache) get(url string) *cachedResponse {
+	elem, ok := rc.entries[url]
+	if !ok {
+		return nil
+	}
+	rc.lru.MoveToFront(elem)
+	return elem.Value.(*cachedResponse)
+}
+
+// prepare adds the validators of a cached response for the request URL, if any
+func (rc *responseCache) prepare(req *http.Request) {
+	if rc == nil || req.Method != http.MethodGet {
+		return
+	}
+	rc.mu.Lock()
+	entry := rc.get(req.URL.String())
+	rc.mu.Unlock()
+	if entry == nil {
+		return
+	}
+	if entry.etag != "" {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	if entry.lastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.lastModified)
+	}
+}
+
+// cached returns the stored body for a 304 response, or nil if there is none
+func (rc *responseCache) cached(req *http.Request, resp *http.Response) []byte {
+	if rc == nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusNotModified {
+		return nil
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if entry := rc.get(req.URL.String()); entry != nil {
+		return entry.body
+	}
+	return nil
+}
+
+// store saves a successful GET response body if the server sent validators
+func (rc *responseCache) store(req *http.Request, resp *http.Response, body []byte) {
+	if rc == nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK {
+		return
+	}
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return
+	}
+	url := req.URL.String()
+	entry := &cachedResponse{
+		url:          url,
+		etag:         etag,
+		lastModified: lastModified,
+		body:         body,
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if elem, ok := rc.entries[url]; ok {
+		elem.Value = entry
+		rc.lru.MoveToFront(elem)
+		return
+	}
+	rc.entries[url] = rc.lru.PushFront(entry)
+	if rc.lru.Len() > rc.maxEntries {
+		oldest := rc.lru.Back()
+		rc.lru.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*cachedResponse).url)
+	}
+}
@@ -0,0 +1,130 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResponseCache_Revalidation(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"code": 0, "message": "OK", "data": {"list": ["en", "ja"]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseCache())
+	params := url.Values{"advertiser_id": {"123"}}
+
+	for i := 0; i < 2; i++ {
+		var result struct {
+			List []string `json:"list"`
+		}
+		require.NoError(t, DoGet(context.Background(), client, "/open_api/v1.3/tool/language/", params, &result))
+		assert.Equal(t, []string{"en", "ja"}, result.List)
+	}
+
+	assert.Equal(t, 2, requests)
+}
+
+func TestWithResponseCache_LastModified(t *testing.T) {
+	const lastModified = "Mon, 01 Jan 2024 00:00:00 GMT"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte(`{"code": 0, "message": "OK", "data": {"value": 1}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseCache())
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(context.Background(), "/open_api/v1.3/tool/carrier/", nil)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"value": 1}`, string(resp.Data))
+	}
+}
+
+func TestWithResponseCache_NoValidators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		assert.Empty(t, r.Header.Get("If-Modified-Since"))
+		_ = json.NewEncoder(w).Encode(Response{Code: ptrInt64(0), Data: json.RawMessage(`{}`)})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseCache())
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/open_api/v1.3/tool/carrier/", nil)
+		require.NoError(t, err)
+	}
+}
+
+func TestWithResponseCache_ErrorNotCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"err"`)
+		_, _ = w.Write([]byte(`{"code": 40001, "message": "bad request"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseCache())
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/open_api/v1.3/tool/carrier/", nil)
+		require.Error(t, err)
+	}
+	assert.Equal(t, 2, requests)
+}
+
+func TestWithResponseCacheSize_EvictsLeastRecentlyUsed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"`+r.URL.Query().Get("id")+`"`)
+		_, _ = w.Write([]byte(`{"code": 0, "message": "OK", "data": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseCacheSize(2))
+	get := func(id string) {
+		_, err := client.Get(context.Background(), "/test/path", url.Values{"id": {id}})
+		require.NoError(t, err)
+	}
+
+	get("a")
+	get("b")
+	get("a") // a is now the most recently used
+	get("c") // evicts b
+
+	assert.Equal(t, 2, client.responseCache.lru.Len())
+	assert.Contains(t, client.responseCache.entries, server.URL+"/test/path?id=a")
+	assert.Contains(t, client.responseCache.entries, server.URL+"/test/path?id=c")
+	assert.NotContains(t, client.responseCache.entries, server.URL+"/test/path?id=b")
+}
+
+func TestWithResponseCacheSize_Disabled(t *testing.T) {
+	client := NewClientWithConfig("test-token", "http://example.com", nil, WithResponseCacheSize(0))
+	assert.Nil(t, client.responseCache)
+}
@@ -0,0 +1,53 @@
+package campaign
+
+import tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+
+// AggregateBudgets sums campaign budgets by currency.
+// The campaign endpoint does not return a currency, so currencies maps each
+// advertiser ID to its account currency (as returned by account.GetAdvertiserInfo).
+// Campaigns whose advertiser is missing from the map are summed under "".
+func AggregateBudgets(campaigns []CampaignStatus, currencies map[string]string) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, c := range campaigns {
+		totals[currencies[c.AdvertiserID]] += c.Budget
+	}
+	return totals
+}
+
+// SumBudgetsByStatus sums campaign budgets by operation status
+func SumBudgetsByStatus(campaigns []CampaignStatus) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, c := range campaigns {
+		totals[c.OperationStatus] += c.Budget
+	}
+	return totals
+}
+
+// CountByStatus counts campaigns by operation status
+func CountByStatus(campaigns []CampaignStatus) map[string]int {
+	counts := make(map[string]int)
+	for _, c := range campaigns {
+		counts[c.OperationStatus]++
+	}
+	return counts
+}
+
+// AggregateBudgetsIn sums campaign budgets into a single total in base currency,
+// converting each account currency with convert. currencies is as for AggregateBudgets;
+// campaigns whose advertiser has no currency make it fail.
+func AggregateBudgetsIn(campaigns []CampaignStatus, currencies map[string]string, base string, convert tiktok.FXConverter) (float64, error) {
+	return tiktok.ConvertTotals(AggregateBudgets(campaigns, currencies), base, convert)
+}
+
+// FilterByBudget returns the campaigns whose budget is within [min, max], inclusive.
+// The campaign endpoint cannot filter by budget, so this runs over fetched results.
+// Campaigns with BUDGET_MODE_INFINITE have no budget and are never included.
+func FilterByBudget(campaigns []CampaignStatus, min, max float64) []CampaignStatus {
+	filtered := make([]CampaignStatus, 0, len(campaigns))
+	for _, c := range campaigns {
+		if !c.GetBudgetMode().IsInfinite() && c.Budget >= min && c.Budget <= max {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
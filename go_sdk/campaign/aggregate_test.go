@@ -0,0 +1,101 @@
+package campaign
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func testCampaigns() []CampaignStatus {
+	return []CampaignStatus{
+		{CampaignID: "c1", AdvertiserID: "adv-us", Budget: 100, OperationStatus: "ENABLE"},
+		{CampaignID: "c2", AdvertiserID: "adv-us", Budget: 250.5, OperationStatus: "DISABLE"},
+		{CampaignID: "c3", AdvertiserID: "adv-jp", Budget: 10000, OperationStatus: "ENABLE"},
+		{CampaignID: "c4", AdvertiserID: "adv-unknown", Budget: 50, OperationStatus: "ENABLE"},
+	}
+}
+
+func TestAggregateBudgets(t *testing.T) {
+	currencies := map[string]string{
+		"adv-us": "USD",
+		"adv-jp": "JPY",
+	}
+
+	totals := AggregateBudgets(testCampaigns(), currencies)
+
+	assert.Len(t, totals, 3)
+	assert.Equal(t, 350.5, totals["USD"])
+	assert.Equal(t, 10000.0, totals["JPY"])
+	assert.Equal(t, 50.0, totals[""])
+}
+
+func TestAggregateBudgetsIn(t *testing.T) {
+	campaigns := []CampaignStatus{
+		{CampaignID: "c1", AdvertiserID: "adv-us", Budget: 100},
+		{CampaignID: "c2", AdvertiserID: "adv-jp", Budget: 15000},
+	}
+	currencies := map[string]string{"adv-us": "USD", "adv-jp": "JPY"}
+
+	var conversions []string
+	toUSD := func(amount float64, from, to string) (float64, error) {
+		conversions = append(conversions, from+"->"+to)
+		if from != "JPY" || to != "USD" {
+			return 0, fmt.Errorf("unexpected conversion %s->%s", from, to)
+		}
+		return amount / 150, nil
+	}
+
+	total, err := AggregateBudgetsIn(campaigns, currencies, "USD", toUSD)
+	require.NoError(t, err)
+	assert.InDelta(t, 200.0, total, 1e-9)
+	assert.Equal(t, []string{"JPY->USD"}, conversions)
+}
+
+func TestAggregateBudgetsIn_UnknownCurrency(t *testing.T) {
+	_, err := AggregateBudgetsIn(testCampaigns(), map[string]string{"adv-us": "USD", "adv-jp": "JPY"}, "USD",
+		func(amount float64, from, to string) (float64, error) { return amount, nil })
+	assert.Error(t, err)
+}
+
+func TestAggregateBudgets_Empty(t *testing.T) {
+	totals := AggregateBudgets(nil, nil)
+	assert.Empty(t, totals)
+}
+
+func TestSumBudgetsByStatus(t *testing.T) {
+	totals := SumBudgetsByStatus(testCampaigns())
+
+	assert.Equal(t, 10150.0, totals["ENABLE"])
+	assert.Equal(t, 250.5, totals["DISABLE"])
+}
+
+func TestCountByStatus(t *testing.T) {
+	counts := CountByStatus(testCampaigns())
+
+	assert.Equal(t, 3, counts["ENABLE"])
+	assert.Equal(t, 1, counts["DISABLE"])
+}
+
+func TestCampaignStatus_GetBudgetMode(t *testing.T) {
+	c := CampaignStatus{BudgetMode: "BUDGET_MODE_DAY"}
+
+	assert.Equal(t, tiktok.BudgetModeDay, c.GetBudgetMode())
+	assert.True(t, c.GetBudgetMode().IsDaily())
+	assert.False(t, c.GetBudgetMode().IsInfinite())
+}
+
+func TestFilterByBudget(t *testing.T) {
+	campaigns := append(testCampaigns(), CampaignStatus{CampaignID: "c5", BudgetMode: "BUDGET_MODE_INFINITE"})
+
+	filtered := FilterByBudget(campaigns, 0, 250.5)
+
+	ids := make([]string, len(filtered))
+	for i, c := range filtered {
+		ids[i] = c.CampaignID
+	}
+	assert.Equal(t, []string{"c1", "c2", "c4"}, ids)
+	assert.Empty(t, FilterByBudget(campaigns, 300, 9999))
+}
@@ -2,6 +2,7 @@ package campaign
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -33,6 +34,11 @@ type CampaignStatus struct {
 	ModifyTime      string  `json:"modify_time"`
 }
 
+// GetBudgetMode returns the campaign's budget mode as a typed value
+func (c *CampaignStatus) GetBudgetMode() tiktok.BudgetMode {
+	return tiktok.BudgetMode(c.BudgetMode)
+}
+
 // GetCampaignResponse represents the response for getting campaigns
 type GetCampaignResponse struct {
 	List     []CampaignStatus `json:"list"`
@@ -41,10 +47,15 @@ type GetCampaignResponse struct {
 
 // GetCampaignRequest represents the request to get campaigns
 type GetCampaignRequest struct {
+	tiktok.BaseListRequest
 	AdvertiserID string     `json:"advertiser_id"`
 	Filtering    *Filtering `json:"filtering,omitempty"`
-	Page         *int64     `json:"page,omitempty"`
-	PageSize     *int64     `json:"page_size,omitempty"`
+	// ExcludeDeleted hides deleted campaigns by filtering on primary_status,
+	// unless Filtering already sets a primary status
+	ExcludeDeleted bool `json:"-"`
+	// ExcludeFieldTypesInResponse trims field groups from the response (e.g. tiktok.ExcludeFieldTypeCostData);
+	// excluded fields are left at their zero values
+	ExcludeFieldTypesInResponse []string `json:"exclude_field_types_in_response,omitempty"`
 }
 
 // Filtering represents filtering options
@@ -58,20 +69,42 @@ type Filtering struct {
 	CreateTimeMax   *string  `json:"create_time_max,omitempty"`
 }
 
+// filtering returns the filter to send, applying ExcludeDeleted without mutating the request
+func (r *GetCampaignRequest) filtering() *Filtering {
+	if !r.ExcludeDeleted || (r.Filtering != nil && r.Filtering.PrimaryStatus != nil) {
+		return r.Filtering
+	}
+
+	var f Filtering
+	if r.Filtering != nil {
+		f = *r.Filtering
+	}
+	status := tiktok.PrimaryStatusNotDelete
+	f.PrimaryStatus = &status
+	return &f
+}
+
 // GetCampaigns gets campaign information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739315828649986
 func (a *API) GetCampaigns(ctx context.Context, req *GetCampaignRequest) (*GetCampaignResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	})
+	// Add pagination, fields and sorting using helper
+	if err := req.Apply(a.client, params); err != nil {
+		return nil, err
+	}
 
 	// Add filtering using helper
-	if err := tiktok.AddJSONParam(params, "filtering", req.Filtering); err != nil {
+	if err := tiktok.AddJSONParam(params, "filtering", req.filtering()); err != nil {
+		return nil, err
+	}
+
+	if err := tiktok.AddStringSlice(params, "exclude_field_types_in_response", req.ExcludeFieldTypesInResponse); err != nil {
 		return nil, err
 	}
 
@@ -94,6 +127,9 @@ type CreateCampaignRequest struct {
 	Budget            *float64 `json:"budget,omitempty"`
 	BudgetMode        *string  `json:"budget_mode,omitempty"`
 	BudgetOptimizeOn  *bool    `json:"budget_optimize_on,omitempty"`
+	BidType           *string  `json:"bid_type,omitempty"`
+	RoasBid           *float64 `json:"roas_bid,omitempty"`
+	DeepBidType       *string  `json:"deep_bid_type,omitempty"`
 	CampaignType      *string  `json:"campaign_type,omitempty"`
 	OperationStatus   *string  `json:"operation_status,omitempty"`
 	OptimizationGoal  *string  `json:"optimization_goal,omitempty"`
@@ -101,6 +137,49 @@ type CreateCampaignRequest struct {
 	SpecialIndustries []string `json:"special_industries,omitempty"`
 }
 
+// Special industries (regulated ad categories)
+const (
+	SpecialIndustryHousing    = "HOUSING"
+	SpecialIndustryEmployment = "EMPLOYMENT"
+	SpecialIndustryCredit     = "CREDIT"
+)
+
+// specialIndustries lists the accepted special_industries values
+var specialIndustries = map[string]bool{
+	SpecialIndustryHousing:    true,
+	SpecialIndustryEmployment: true,
+	SpecialIndustryCredit:     true,
+}
+
+// specialIndustryObjectives lists the objectives that accept special_industries
+var specialIndustryObjectives = map[string]bool{
+	"REACH":           true,
+	"TRAFFIC":         true,
+	"VIDEO_VIEWS":     true,
+	"ENGAGEMENT":      true,
+	"LEAD_GENERATION": true,
+	"WEB_CONVERSIONS": true,
+}
+
+// Validate checks the request for combinations the API would reject
+func (r *CreateCampaignRequest) Validate() error {
+	if r.BudgetOptimizeOn != nil && *r.BudgetOptimizeOn && (r.Budget == nil || *r.Budget <= 0) {
+		return errors.New("a campaign budget is required when budget optimization is on")
+	}
+	if len(r.SpecialIndustries) == 0 {
+		return nil
+	}
+	for _, industry := range r.SpecialIndustries {
+		if !specialIndustries[industry] {
+			return fmt.Errorf("invalid special industry %q", industry)
+		}
+	}
+	if !specialIndustryObjectives[r.ObjectiveType] {
+		return fmt.Errorf("special industries are not supported for objective %s", r.ObjectiveType)
+	}
+	return nil
+}
+
 // CreateCampaignResponse represents the response from creating a campaign
 type CreateCampaignResponse struct {
 	CampaignID string `json:"campaign_id"`
@@ -109,11 +188,150 @@ type CreateCampaignResponse struct {
 // CreateCampaign creates a new campaign
 // Reference: https://business-api.tiktok.com/portal/docs?id=1739318962329602
 func (a *API) CreateCampaign(ctx context.Context, req *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Use generic DoPost helper
 	var resp CreateCampaignResponse
 	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/campaign/create/", req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to create campaign: %w", err)
 	}
 
+	a.client.Manifest().Record(tiktok.EntityTypeCampaign, req.AdvertiserID, resp.CampaignID)
+
 	return &resp, nil
 }
+
+// CreateAndGetCampaign creates a campaign and fetches it, returning the full campaign
+func (a *API) CreateAndGetCampaign(ctx context.Context, req *CreateCampaignRequest) (*CampaignStatus, error) {
+	created, err := a.CreateCampaign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.GetCampaigns(ctx, &GetCampaignRequest{
+		AdvertiserID: req.AdvertiserID,
+		Filtering:    &Filtering{CampaignIDs: []string{created.CampaignID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("campaign %s was created but could not be fetched: %w", created.CampaignID, err)
+	}
+	if len(resp.List) == 0 {
+		return nil, fmt.Errorf("campaign %s was created but not found", created.CampaignID)
+	}
+
+	return &resp.List[0], nil
+}
+
+// UpdateCampaignRequest represents the request to update a campaign.
+// Only the optional fields that are set are sent and changed.
+type UpdateCampaignRequest struct {
+	AdvertiserID      string   `json:"advertiser_id"`
+	CampaignID        string   `json:"campaign_id"`
+	CampaignName      *string  `json:"campaign_name,omitempty"`
+	Budget            *float64 `json:"budget,omitempty"`
+	BudgetMode        *string  `json:"budget_mode,omitempty"`
+	SpecialIndustries []string `json:"special_industries,omitempty"`
+}
+
+// Validate checks the request for values the API would reject
+func (r *UpdateCampaignRequest) Validate() error {
+	if r.AdvertiserID == "" {
+		return errors.New("advertiser ID is required")
+	}
+	if r.CampaignID == "" {
+		return errors.New("campaign ID is required")
+	}
+	for _, industry := range r.SpecialIndustries {
+		if !specialIndustries[industry] {
+			return fmt.Errorf("invalid special industry %q", industry)
+		}
+	}
+	return nil
+}
+
+// UpdateCampaignResponse represents the response from updating a campaign
+type UpdateCampaignResponse struct {
+	CampaignID string `json:"campaign_id"`
+}
+
+// UpdateCampaign updates the name, budget or special industries of a campaign
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739320422086657
+func (a *API) UpdateCampaign(ctx context.Context, req *UpdateCampaignRequest) (*UpdateCampaignResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	body := *req
+	body.AdvertiserID = tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID)
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateCampaignResponse
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/campaign/update/", &body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to update campaign: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// Operation statuses for UpdateCampaignStatus
+const (
+	OperationStatusEnable  = "ENABLE"
+	OperationStatusDisable = "DISABLE"
+	OperationStatusDelete  = "DELETE"
+)
+
+// operationStatuses lists the accepted operation_status values
+var operationStatuses = map[string]bool{
+	OperationStatusEnable:  true,
+	OperationStatusDisable: true,
+	OperationStatusDelete:  true,
+}
+
+// MaxStatusUpdateCampaigns is the largest number of campaign IDs accepted by one status update
+// (campaign_ids allows 1-20 IDs).
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739320994354178
+const MaxStatusUpdateCampaigns = 20
+
+// campaignStatusUpdateRequest is the body of a campaign status update
+type campaignStatusUpdateRequest struct {
+	AdvertiserID    string   `json:"advertiser_id"`
+	CampaignIDs     []string `json:"campaign_ids"`
+	OperationStatus string   `json:"operation_status"`
+}
+
+// UpdateCampaignStatus enables, disables or deletes up to MaxStatusUpdateCampaigns campaigns.
+// The raw response is returned so that callers can inspect the per-campaign results in its data;
+// on an API error it is returned along with the error.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739320994354178
+func (a *API) UpdateCampaignStatus(ctx context.Context, advertiserID string, campaignIDs []string, operationStatus string) (*tiktok.Response, error) {
+	if !operationStatuses[operationStatus] {
+		return nil, fmt.Errorf("invalid operation status %q", operationStatus)
+	}
+	if len(campaignIDs) == 0 {
+		return nil, errors.New("at least one campaign ID is required")
+	}
+	if len(campaignIDs) > MaxStatusUpdateCampaigns {
+		return nil, fmt.Errorf("at most %d campaign IDs can be updated at once, got %d", MaxStatusUpdateCampaigns, len(campaignIDs))
+	}
+
+	body := campaignStatusUpdateRequest{
+		AdvertiserID:    tiktok.ResolveAdvertiserID(ctx, advertiserID),
+		CampaignIDs:     campaignIDs,
+		OperationStatus: operationStatus,
+	}
+
+	resp, err := a.client.Post(ctx, "/open_api/v1.3/campaign/status/update/", nil, &body)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update campaign status: %w", err)
+	}
+
+	return resp, nil
+}
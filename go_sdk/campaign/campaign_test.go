@@ -3,9 +3,13 @@ package campaign
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,9 +123,8 @@ func TestGetCampaigns_WithPagination(t *testing.T) {
 	page := int64(2)
 	pageSize := int64(25)
 	result, err := api.GetCampaigns(context.Background(), &GetCampaignRequest{
-		AdvertiserID: "123456789",
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	})
 
 	require.NoError(t, err)
@@ -371,6 +374,617 @@ func TestGetCampaigns_EmptyResult(t *testing.T) {
 	assert.Equal(t, int64(0), result.PageInfo.TotalNumber)
 }
 
+func TestGetCampaigns_NilRequest(t *testing.T) {
+	api := NewAPI(tiktok.NewClient("test-token"))
+
+	result, err := api.GetCampaigns(context.Background(), nil)
+	require.ErrorIs(t, err, tiktok.ErrNilRequest)
+	assert.Nil(t, result)
+
+	created, err := api.CreateCampaign(context.Background(), nil)
+	require.ErrorIs(t, err, tiktok.ErrNilRequest)
+	assert.Nil(t, created)
+}
+
+func TestGetCampaigns_AdvertiserIDFromContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "adv-from-ctx", r.URL.Query().Get("advertiser_id"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	ctx := tiktok.WithAdvertiserID(context.Background(), "adv-from-ctx")
+	_, err := api.GetCampaigns(ctx, &GetCampaignRequest{})
+	require.NoError(t, err)
+}
+
+func TestGetCampaigns_ExplicitAdvertiserIDWins(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "adv-explicit", r.URL.Query().Get("advertiser_id"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	ctx := tiktok.WithAdvertiserID(context.Background(), "adv-from-ctx")
+	_, err := api.GetCampaigns(ctx, &GetCampaignRequest{AdvertiserID: "adv-explicit"})
+	require.NoError(t, err)
+}
+
+func TestGetCampaigns_ExcludeDeleted(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *GetCampaignRequest
+		filtering string
+	}{
+		{
+			name:      "without ExcludeDeleted sends no filter",
+			req:       &GetCampaignRequest{AdvertiserID: "123"},
+			filtering: "",
+		},
+		{
+			name:      "ExcludeDeleted adds primary status",
+			req:       &GetCampaignRequest{AdvertiserID: "123", ExcludeDeleted: true},
+			filtering: `{"primary_status":"STATUS_NOT_DELETE"}`,
+		},
+		{
+			name: "ExcludeDeleted keeps other filters",
+			req: &GetCampaignRequest{
+				AdvertiserID:   "123",
+				ExcludeDeleted: true,
+				Filtering:      &Filtering{CampaignIDs: []string{"c1"}},
+			},
+			filtering: `{"campaign_ids":["c1"],"primary_status":"STATUS_NOT_DELETE"}`,
+		},
+		{
+			name: "explicit primary status wins",
+			req: &GetCampaignRequest{
+				AdvertiserID:   "123",
+				ExcludeDeleted: true,
+				Filtering:      &Filtering{PrimaryStatus: ptrString("STATUS_DELETE")},
+			},
+			filtering: `{"primary_status":"STATUS_DELETE"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.filtering, r.URL.Query().Get("filtering"))
+
+				response := tiktok.Response{
+					Code: ptrInt64(0),
+					Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+				}
+				json.NewEncoder(w).Encode(response)
+			}))
+			defer server.Close()
+
+			client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+			api := NewAPI(client)
+
+			_, err := api.GetCampaigns(context.Background(), tt.req)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetCampaigns_ExcludeDeletedDoesNotMutateRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	req := &GetCampaignRequest{
+		AdvertiserID:   "123",
+		ExcludeDeleted: true,
+		Filtering:      &Filtering{CampaignIDs: []string{"c1"}},
+	}
+	_, err := api.GetCampaigns(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, req.Filtering.PrimaryStatus)
+}
+
+func TestGetCampaigns_DefaultPageSize(t *testing.T) {
+	var gotPageSize string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPageSize = r.URL.Query().Get("page_size")
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil, tiktok.WithDefaultPageSize(1000))
+	api := NewAPI(client)
+
+	_, err := api.GetCampaigns(context.Background(), &GetCampaignRequest{AdvertiserID: "123"})
+	require.NoError(t, err)
+	assert.Equal(t, "1000", gotPageSize)
+
+	_, err = api.GetCampaigns(context.Background(), &GetCampaignRequest{AdvertiserID: "123", BaseListRequest: tiktok.BaseListRequest{PageSize: ptrInt64(10)}})
+	require.NoError(t, err)
+	assert.Equal(t, "10", gotPageSize)
+}
+
+func TestCreateCampaignRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CreateCampaignRequest
+		wantErr bool
+	}{
+		{
+			name: "no special industries",
+			req:  CreateCampaignRequest{ObjectiveType: "APP_PROMOTION"},
+		},
+		{
+			name: "housing with traffic",
+			req:  CreateCampaignRequest{ObjectiveType: "TRAFFIC", SpecialIndustries: []string{SpecialIndustryHousing}},
+		},
+		{
+			name: "employment and credit with lead generation",
+			req: CreateCampaignRequest{
+				ObjectiveType:     "LEAD_GENERATION",
+				SpecialIndustries: []string{SpecialIndustryEmployment, SpecialIndustryCredit},
+			},
+		},
+		{
+			name:    "unknown industry",
+			req:     CreateCampaignRequest{ObjectiveType: "TRAFFIC", SpecialIndustries: []string{"GAMBLING"}},
+			wantErr: true,
+		},
+		{
+			name:    "incompatible objective",
+			req:     CreateCampaignRequest{ObjectiveType: "APP_PROMOTION", SpecialIndustries: []string{SpecialIndustryCredit}},
+			wantErr: true,
+		},
+		{
+			name: "budget optimization with budget",
+			req:  CreateCampaignRequest{ObjectiveType: "TRAFFIC", BudgetOptimizeOn: ptrBool(true), Budget: ptrFloat64(100)},
+		},
+		{
+			name:    "budget optimization without budget",
+			req:     CreateCampaignRequest{ObjectiveType: "TRAFFIC", BudgetOptimizeOn: ptrBool(true)},
+			wantErr: true,
+		},
+		{
+			name: "budget optimization off without budget",
+			req:  CreateCampaignRequest{ObjectiveType: "TRAFFIC", BudgetOptimizeOn: ptrBool(false)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateCampaign_InvalidSpecialIndustry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.CreateCampaign(context.Background(), &CreateCampaignRequest{
+		AdvertiserID:      "123",
+		CampaignName:      "Housing campaign",
+		ObjectiveType:     "TRAFFIC",
+		SpecialIndustries: []string{"housing"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "housing")
+}
+
+func TestGetCampaigns_ExcludeFieldTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `["COST_DATA"]`, r.URL.Query().Get("exclude_field_types_in_response"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"list": [{"campaign_id": "c1", "campaign_name": "No Cost", "operation_status": "ENABLE"}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetCampaigns(context.Background(), &GetCampaignRequest{
+		AdvertiserID:                "123",
+		ExcludeFieldTypesInResponse: []string{tiktok.ExcludeFieldTypeCostData},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.List, 1)
+	assert.Equal(t, "c1", resp.List[0].CampaignID)
+	assert.Zero(t, resp.List[0].Budget)
+	assert.Empty(t, resp.List[0].BudgetMode)
+}
+
+func TestGetCampaigns_NoExcludeFieldTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := r.URL.Query()["exclude_field_types_in_response"]
+		assert.False(t, ok)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetCampaigns(context.Background(), &GetCampaignRequest{AdvertiserID: "123"})
+	require.NoError(t, err)
+}
+
+func TestCreateCampaign_BudgetOptimization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/campaign/create/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["budget_optimize_on"])
+		assert.Equal(t, 500.0, body["budget"])
+		assert.Equal(t, "BUDGET_MODE_DAY", body["budget_mode"])
+		assert.Equal(t, "BID_TYPE_CUSTOM", body["bid_type"])
+		assert.Equal(t, 2.5, body["roas_bid"])
+		assert.Equal(t, "VO_MIN_ROAS", body["deep_bid_type"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"campaign_id":"cbo-1"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.CreateCampaign(context.Background(), &CreateCampaignRequest{
+		AdvertiserID:     "123",
+		CampaignName:     "CBO campaign",
+		ObjectiveType:    "WEB_CONVERSIONS",
+		BudgetOptimizeOn: ptrBool(true),
+		Budget:           ptrFloat64(500),
+		BudgetMode:       ptrString(string(tiktok.BudgetModeDay)),
+		BidType:          ptrString("BID_TYPE_CUSTOM"),
+		RoasBid:          ptrFloat64(2.5),
+		DeepBidType:      ptrString("VO_MIN_ROAS"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cbo-1", resp.CampaignID)
+}
+
+func TestCreateCampaign_BudgetOptimizationWithoutBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.CreateCampaign(context.Background(), &CreateCampaignRequest{
+		AdvertiserID:     "123",
+		CampaignName:     "CBO campaign",
+		ObjectiveType:    "TRAFFIC",
+		BudgetOptimizeOn: ptrBool(true),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "budget")
+}
+
+func TestCreateAndGetCampaign(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data string
+		switch r.URL.Path {
+		case "/open_api/v1.3/campaign/create/":
+			assert.Equal(t, http.MethodPost, r.Method)
+			data = `{"campaign_id": "c-001"}`
+		case "/open_api/v1.3/campaign/get/":
+			assert.Equal(t, `{"campaign_ids":["c-001"]}`, r.URL.Query().Get("filtering"))
+			data = `{
+				"list": [{"campaign_id": "c-001", "campaign_name": "Launch", "advertiser_id": "123456789", "objective_type": "TRAFFIC", "budget": 100, "operation_status": "ENABLE"}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(data)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	info, err := api.CreateAndGetCampaign(context.Background(), &CreateCampaignRequest{
+		AdvertiserID:  "123456789",
+		CampaignName:  "Launch",
+		ObjectiveType: "TRAFFIC",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CampaignStatus{
+		CampaignID:      "c-001",
+		CampaignName:    "Launch",
+		AdvertiserID:    "123456789",
+		ObjectiveType:   "TRAFFIC",
+		Budget:          100,
+		OperationStatus: "ENABLE",
+	}, *info)
+}
+
+func TestCreateCampaign_RecordsManifest(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{"campaign_id": "c-001"}`)})
+		case 2:
+			json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(40002), Message: ptrString("invalid campaign name")})
+		default:
+			json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{"campaign_id": "c-003"}`)})
+		}
+	}))
+	defer server.Close()
+
+	manifest := tiktok.NewManifest()
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil, tiktok.WithManifest(manifest))
+	api := NewAPI(client)
+
+	for _, advertiserID := range []string{"adv-1", "adv-1", "adv-2"} {
+		_, _ = api.CreateCampaign(context.Background(), &CreateCampaignRequest{
+			AdvertiserID:  advertiserID,
+			CampaignName:  "Launch",
+			ObjectiveType: "TRAFFIC",
+		})
+	}
+
+	entries := manifest.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, tiktok.EntityTypeCampaign, entries[0].Type)
+	assert.Equal(t, "c-001", entries[0].ID)
+	assert.Equal(t, "adv-1", entries[0].AdvertiserID)
+	assert.Equal(t, tiktok.EntityTypeCampaign, entries[1].Type)
+	assert.Equal(t, "c-003", entries[1].ID)
+	assert.Equal(t, "adv-2", entries[1].AdvertiserID)
+}
+
+func TestUpdateCampaign(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/campaign/update/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"advertiser_id": "123",
+			"campaign_id":   "c-1",
+			"budget":        750.0,
+		}, body)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"campaign_id":"c-1"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.UpdateCampaign(context.Background(), &UpdateCampaignRequest{
+		AdvertiserID: "123",
+		CampaignID:   "c-1",
+		Budget:       ptrFloat64(750),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "c-1", resp.CampaignID)
+}
+
+func TestUpdateCampaign_AllFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Renamed", body["campaign_name"])
+		assert.Equal(t, "BUDGET_MODE_TOTAL", body["budget_mode"])
+		assert.Equal(t, []interface{}{"HOUSING"}, body["special_industries"])
+
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(0), Data: json.RawMessage(`{"campaign_id":"c-1"}`)})
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.UpdateCampaign(context.Background(), &UpdateCampaignRequest{
+		AdvertiserID:      "123",
+		CampaignID:        "c-1",
+		CampaignName:      ptrString("Renamed"),
+		Budget:            ptrFloat64(1000),
+		BudgetMode:        ptrString(string(tiktok.BudgetModeTotal)),
+		SpecialIndustries: []string{SpecialIndustryHousing},
+	})
+	require.NoError(t, err)
+}
+
+func TestUpdateCampaign_Validation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", server.URL, nil))
+
+	_, err := api.UpdateCampaign(context.Background(), &UpdateCampaignRequest{CampaignID: "c-1"})
+	assert.EqualError(t, err, "advertiser ID is required")
+
+	_, err = api.UpdateCampaign(context.Background(), &UpdateCampaignRequest{AdvertiserID: "123"})
+	assert.EqualError(t, err, "campaign ID is required")
+
+	_, err = api.UpdateCampaign(context.Background(), nil)
+	assert.ErrorIs(t, err, tiktok.ErrNilRequest)
+}
+
+func TestUpdateCampaignStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/campaign/status/update/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"advertiser_id":    "123",
+			"campaign_ids":     []interface{}{"c-1", "c-2"},
+			"operation_status": "DISABLE",
+		}, body)
+
+		response := tiktok.Response{
+			Code:      ptrInt64(0),
+			RequestID: ptrString("req-1"),
+			Data:      json.RawMessage(`{"campaign_ids":["c-1","c-2"],"status":"DISABLE"}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.UpdateCampaignStatus(context.Background(), "123", []string{"c-1", "c-2"}, OperationStatusDisable)
+	require.NoError(t, err)
+	assert.Equal(t, "req-1", *resp.RequestID)
+	assert.JSONEq(t, `{"campaign_ids":["c-1","c-2"],"status":"DISABLE"}`, string(resp.Data))
+}
+
+func TestUpdateCampaignStatus_Validation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", server.URL, nil))
+
+	_, err := api.UpdateCampaignStatus(context.Background(), "123", []string{"c-1"}, "PAUSE")
+	assert.EqualError(t, err, `invalid operation status "PAUSE"`)
+
+	_, err = api.UpdateCampaignStatus(context.Background(), "123", nil, OperationStatusEnable)
+	assert.EqualError(t, err, "at least one campaign ID is required")
+
+	ids := make([]string, MaxStatusUpdateCampaigns+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("c-%d", i)
+	}
+	_, err = api.UpdateCampaignStatus(context.Background(), "123", ids, OperationStatusDelete)
+	assert.EqualError(t, err, "at most 20 campaign IDs can be updated at once, got 21")
+}
+
+func TestUpdateCampaignStatus_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(tiktok.Response{Code: ptrInt64(40002), Message: ptrString("campaign c-9 not found")})
+	}))
+	defer server.Close()
+
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", server.URL, nil))
+
+	resp, err := api.UpdateCampaignStatus(context.Background(), "123", []string{"c-9"}, OperationStatusEnable)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, int64(40002), *resp.Code)
+}
+
+func TestGetCampaigns_RequestCoalescing(t *testing.T) {
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+		<-release
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"list":[{"campaign_id":"c-1","campaign_name":"Shared"}],"page_info":{"page":1,"page_size":10,"total_number":1,"total_page":1}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil, tiktok.WithRequestCoalescing())
+	api := NewAPI(client)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]*GetCampaignResponse, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = api.GetCampaigns(context.Background(), &GetCampaignRequest{AdvertiserID: "123"})
+		}(i)
+	}
+
+	<-started
+	// Give the other callers time to join the in-flight request
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i].List, 1)
+		assert.Equal(t, "Shared", results[i].List[0].CampaignName)
+	}
+}
+
 // Helper functions
 func ptrInt64(i int64) *int64 {
 	return &i
@@ -379,3 +993,11 @@ func ptrInt64(i int64) *int64 {
 func ptrString(s string) *string {
 	return &s
 }
+
+func ptrBool(b bool) *bool {
+	return &b
+}
+
+func ptrFloat64(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,59 @@
+package campaign
+
+import tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+
+// CampaignChange describes a campaign present in both snapshots whose fields changed
+type CampaignChange struct {
+	CampaignID string
+	Old        CampaignStatus
+	New        CampaignStatus
+	Deltas     []tiktok.FieldDelta
+}
+
+// CampaignDiff is the result of comparing two campaign snapshots
+type CampaignDiff struct {
+	Added   []CampaignStatus
+	Removed []CampaignStatus
+	Changed []CampaignChange
+}
+
+// IsEmpty reports whether the snapshots are identical
+func (d *CampaignDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares two campaign snapshots by campaign ID.
+// Added and Changed follow the order of newCampaigns; Removed follows oldCampaigns.
+func Diff(oldCampaigns, newCampaigns []CampaignStatus) *CampaignDiff {
+	previous := make(map[string]CampaignStatus, len(oldCampaigns))
+	for _, c := range oldCampaigns {
+		previous[c.CampaignID] = c
+	}
+
+	diff := &CampaignDiff{}
+	seen := make(map[string]bool, len(newCampaigns))
+	for _, c := range newCampaigns {
+		seen[c.CampaignID] = true
+		old, ok := previous[c.CampaignID]
+		if !ok {
+			diff.Added = append(diff.Added, c)
+			continue
+		}
+		if deltas := tiktok.DiffFields(old, c); len(deltas) > 0 {
+			diff.Changed = append(diff.Changed, CampaignChange{
+				CampaignID: c.CampaignID,
+				Old:        old,
+				New:        c,
+				Deltas:     deltas,
+			})
+		}
+	}
+
+	for _, c := range oldCampaigns {
+		if !seen[c.CampaignID] {
+			diff.Removed = append(diff.Removed, c)
+		}
+	}
+
+	return diff
+}
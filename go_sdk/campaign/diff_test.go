@@ -0,0 +1,49 @@
+package campaign
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestDiff(t *testing.T) {
+	before := []CampaignStatus{
+		{CampaignID: "c1", CampaignName: "Spring", Budget: 100, OperationStatus: "ENABLE"},
+		{CampaignID: "c2", CampaignName: "Summer", Budget: 200, OperationStatus: "ENABLE"},
+	}
+	after := []CampaignStatus{
+		{CampaignID: "c1", CampaignName: "Spring", Budget: 150, OperationStatus: "ENABLE"},
+		{CampaignID: "c2", CampaignName: "Summer", Budget: 200, OperationStatus: "ENABLE"},
+		{CampaignID: "c3", CampaignName: "Autumn", Budget: 50, OperationStatus: "DISABLE"},
+	}
+
+	diff := Diff(before, after)
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "c3", diff.Added[0].CampaignID)
+	assert.Empty(t, diff.Removed)
+
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "c1", diff.Changed[0].CampaignID)
+	assert.Equal(t, []tiktok.FieldDelta{{Field: "budget", Old: 100.0, New: 150.0}}, diff.Changed[0].Deltas)
+	assert.False(t, diff.IsEmpty())
+}
+
+func TestDiff_Removed(t *testing.T) {
+	before := []CampaignStatus{{CampaignID: "c1"}, {CampaignID: "c2"}}
+	after := []CampaignStatus{{CampaignID: "c2"}}
+
+	diff := Diff(before, after)
+
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "c1", diff.Removed[0].CampaignID)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Changed)
+}
+
+func TestDiff_Identical(t *testing.T) {
+	diff := Diff(testCampaigns(), testCampaigns())
+	assert.True(t, diff.IsEmpty())
+}
@@ -0,0 +1,32 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// FindCampaignsByName gets all campaigns whose name contains name, handling pagination
+func (a *API) FindCampaignsByName(ctx context.Context, advertiserID, name string) ([]CampaignStatus, error) {
+	if name == "" {
+		return nil, errors.New("campaign name cannot be empty")
+	}
+
+	req := &GetCampaignRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    &Filtering{CampaignName: &name},
+	}
+
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]CampaignStatus, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
+
+		resp, err := a.GetCampaigns(ctx, req)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to find campaigns page %d: %w", page, err)
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+}
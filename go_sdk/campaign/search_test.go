@@ -0,0 +1,54 @@
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestFindCampaignsByName(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/open_api/v1.3/campaign/get/", r.URL.Path)
+		assert.Equal(t, `{"campaign_name":"Summer"}`, r.URL.Query().Get("filtering"))
+
+		page := r.URL.Query().Get("page")
+		data := fmt.Sprintf(`{
+			"list": [{"campaign_id": "c%s", "campaign_name": "Summer Sale %s"}],
+			"page_info": {"page": %s, "page_size": 1000, "total_number": 2, "total_page": 2}
+		}`, page, page, page)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	campaigns, err := api.FindCampaignsByName(context.Background(), "123456", "Summer")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	require.Len(t, campaigns, 2)
+	assert.Equal(t, "c1", campaigns[0].CampaignID)
+	assert.Equal(t, "c2", campaigns[1].CampaignID)
+}
+
+func TestFindCampaignsByName_EmptyName(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.FindCampaignsByName(context.Background(), "123456", "")
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -17,10 +18,27 @@ type Client struct {
 	baseURL     string
 	httpClient  *http.Client
 	accessToken string
+
+	rateLimitRetries   int
+	rateLimitBaseDelay time.Duration
+	retryBudget        *retryBudget
+	responseCache      *responseCache
+	manifest           *Manifest
+	advertiserMeta     *advertiserMetaCache
+	inflight           *inflightGroup
+	sleep              func(ctx context.Context, d time.Duration) error
+
+	defaultPageSize int64
+
+	logger     Logger
+	validation ValidationMode
 }
 
+// ClientOption configures optional client behavior
+type ClientOption func(*Client)
+
 // NewClient creates a new TikTok Business API client
-func NewClient(accessToken string) *Client {
+func NewClient(accessToken string, opts ...ClientOption) *Client {
 	baseURL := "https://business-api.tiktok.com"
 
 	// Check if sandbox mode is enabled via environment variable
@@ -28,33 +46,39 @@ func NewClient(accessToken string) *Client {
 		baseURL = "https://sandbox-ads.tiktok.com"
 	}
 
-	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		accessToken: accessToken,
-	}
+	return newClient(accessToken, baseURL, NewDefaultHTTPClient(), opts)
 }
 
 // NewClientWithConfig creates a new client with custom configuration
-func NewClientWithConfig(accessToken string, baseURL string, httpClient *http.Client) *Client {
+func NewClientWithConfig(accessToken string, baseURL string, httpClient *http.Client, opts ...ClientOption) *Client {
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: 30 * time.Second,
-		}
+		httpClient = NewDefaultHTTPClient()
 	}
 	if baseURL == "" {
 		baseURL = "https://business-api.tiktok.com"
 	}
-	return &Client{
-		baseURL:     baseURL,
-		httpClient:  httpClient,
-		accessToken: accessToken,
+	return newClient(accessToken, baseURL, httpClient, opts)
+}
+
+func newClient(accessToken, baseURL string, httpClient *http.Client, opts []ClientOption) *Client {
+	c := &Client{
+		baseURL:            baseURL,
+		httpClient:         httpClient,
+		accessToken:        accessToken,
+		rateLimitRetries:   defaultRateLimitRetries,
+		rateLimitBaseDelay: defaultRateLimitBaseDelay,
+		advertiserMeta:     newAdvertiserMetaCache(DefaultAdvertiserMetaTTL),
+		sleep:              sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
-// doRequest performs an HTTP request and returns the response
+// doRequest performs an HTTP request and returns the response.
+// Rate-limited GETs, and other requests whose ctx is marked with WithIdempotent, are retried
+// with exponential backoff, within the retry budget if one is set.
 func (c *Client) doRequest(ctx context.Context, method, path string, queryParams url.Values, body interface{}) (*Response, error) {
 	// Build URL
 	fullURL := c.baseURL + path
@@ -63,17 +87,53 @@ func (c *Client) doRequest(ctx context.Context, method, path string, queryParams
 		fullURL += "?" + queryParams.Encode()
 	}
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
+	if method == http.MethodGet && c.inflight != nil {
+		return c.inflight.do(ctx, method+" "+fullURL, func(ctx context.Context) (*Response, error) {
+			return c.sendWithRetry(ctx, method, fullURL, jsonBody)
+		})
+	}
+
+	return c.sendWithRetry(ctx, method, fullURL, jsonBody)
+}
+
+// sendWithRetry sends the request, retrying rate-limited responses when the request is retryable
+func (c *Client) sendWithRetry(ctx context.Context, method, fullURL string, jsonBody []byte) (*Response, error) {
+	c.retryBudget.deposit()
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if jsonBody != nil {
+			reqBody = bytes.NewReader(jsonBody)
+		}
+
+		apiResp, err := c.send(ctx, method, fullURL, reqBody, int64(len(jsonBody)))
+
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && errResp.Code == CodeRateLimited && retryable(ctx, method) && attempt < c.rateLimitRetries && c.retryBudget.withdraw() {
+			if sleepErr := c.sleep(ctx, c.rateLimitDelay(attempt)); sleepErr != nil {
+				return nil, sleepErr
+			}
+			continue
+		}
+
+		return apiResp, err
+	}
+}
+
+// send performs a single HTTP round trip and parses the API envelope.
+// A negative contentLength sends body with chunked transfer encoding.
+func (c *Client) send(ctx context.Context, method, fullURL string, body io.Reader, contentLength int64) (*Response, error) {
 	// Create HTTP request
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -83,8 +143,13 @@ func (c *Client) doRequest(ctx context.Context, method, path string, queryParams
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
+		if contentLength >= 0 {
+			req.ContentLength = contentLength
+		}
 	}
 
+	c.responseCache.prepare(req)
+
 	// Execute request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -98,6 +163,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, queryParams
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	// Reuse the cached body when the server reports it unchanged
+	if cached := c.responseCache.cached(req, resp); cached != nil {
+		respBody = cached
+	}
+
 	// Parse response
 	var apiResp Response
 	if err := json.Unmarshal(respBody, &apiResp); err != nil {
@@ -113,9 +183,12 @@ func (c *Client) doRequest(ctx context.Context, method, path string, queryParams
 		if apiResp.RequestID != nil {
 			errResp.RequestID = *apiResp.RequestID
 		}
+		errResp.LogID = resp.Header.Get(logIDHeader)
 		return &apiResp, errResp
 	}
 
+	c.responseCache.store(req, resp, respBody)
+
 	return &apiResp, nil
 }
 
@@ -129,6 +202,17 @@ func (c *Client) Post(ctx context.Context, path string, queryParams url.Values,
 	return c.doRequest(ctx, http.MethodPost, path, queryParams, body)
 }
 
+// PostReader performs a POST request with a pre-serialized JSON body.
+// The body is streamed as-is, so it is not retried on rate limiting.
+// Pass a negative contentLength if the length is unknown.
+func (c *Client) PostReader(ctx context.Context, path string, queryParams url.Values, body io.Reader, contentLength int64) (*Response, error) {
+	fullURL := c.baseURL + path
+	if len(queryParams) > 0 {
+		fullURL += "?" + queryParams.Encode()
+	}
+	return c.send(ctx, http.MethodPost, fullURL, body, contentLength)
+}
+
 // Put performs a PUT request
 func (c *Client) Put(ctx context.Context, path string, queryParams url.Values, body interface{}) (*Response, error) {
 	return c.doRequest(ctx, http.MethodPut, path, queryParams, body)
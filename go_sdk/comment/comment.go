@@ -0,0 +1,100 @@
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// API represents the Comment API client
+type API struct {
+	client *tiktok.Client
+}
+
+// NewAPI creates a new Comment API client
+func NewAPI(client *tiktok.Client) *API {
+	return &API{
+		client: client,
+	}
+}
+
+// maxBlockedWordPageSize is the largest page size accepted by the blocked word list endpoint
+const maxBlockedWordPageSize int64 = 500
+
+// BlockedWord represents a blocked comment keyword
+type BlockedWord struct {
+	Content string `json:"bw_content"`
+}
+
+// BlockedWordListResponse represents the response for listing blocked words
+type BlockedWordListResponse struct {
+	List     []BlockedWord   `json:"list"`
+	PageInfo tiktok.PageInfo `json:"page_info"`
+}
+
+// ListBlockedWords gets one page of blocked comment keywords for an ad account.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739029260837889
+func (a *API) ListBlockedWords(ctx context.Context, advertiserID string, page, pageSize int64) (*BlockedWordListResponse, error) {
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
+
+	if err := tiktok.AddJSONParam(params, "page_info", map[string]int64{"page": page, "page_size": pageSize}); err != nil {
+		return nil, err
+	}
+
+	var resp BlockedWordListResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/blockedword/list/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list blocked words: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// GetCommentBlockKeywords gets all blocked comment keywords for an ad account
+func (a *API) GetCommentBlockKeywords(ctx context.Context, advertiserID string) ([]string, error) {
+	words, err := tiktok.Paginate(ctx, maxBlockedWordPageSize, func(ctx context.Context, page, pageSize int64) ([]BlockedWord, tiktok.PageInfo, error) {
+		resp, err := a.ListBlockedWords(ctx, advertiserID, page, pageSize)
+		if err != nil {
+			return nil, tiktok.PageInfo{}, err
+		}
+		return resp.List, resp.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := make([]string, len(words))
+	for i, w := range words {
+		keywords[i] = w.Content
+	}
+	return keywords, nil
+}
+
+// blockedWordCreateRequest represents the request body for adding blocked words
+type blockedWordCreateRequest struct {
+	AdvertiserID string   `json:"advertiser_id"`
+	BlockedWords []string `json:"blocked_words"`
+}
+
+// AddCommentBlockKeywords adds keywords to the comment block list of an ad account.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739029218470913
+func (a *API) AddCommentBlockKeywords(ctx context.Context, advertiserID string, keywords []string) error {
+	if len(keywords) == 0 {
+		return errors.New("at least one keyword is required")
+	}
+
+	body := blockedWordCreateRequest{
+		AdvertiserID: tiktok.ResolveAdvertiserID(ctx, advertiserID),
+		BlockedWords: keywords,
+	}
+
+	var resp struct{}
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/blockedword/create/", body, &resp); err != nil {
+		return fmt.Errorf("failed to add blocked words: %w", err)
+	}
+
+	return nil
+}
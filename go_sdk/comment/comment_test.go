@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestNewAPI(t *testing.T) {
+	client := tiktok.NewClient("test-token")
+	api := NewAPI(client)
+
+	assert.NotNil(t, api)
+	assert.Equal(t, client, api.client)
+}
+
+func TestGetCommentBlockKeywords(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/open_api/v1.3/blockedword/list/", r.URL.Path)
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+
+		var pageInfo map[string]int64
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("page_info")), &pageInfo))
+		assert.Equal(t, int64(500), pageInfo["page_size"])
+
+		var data string
+		switch pageInfo["page"] {
+		case 1:
+			data = `{"list":[{"bw_content":"scam"},{"bw_content":"fake"}],"page_info":{"page":1,"page_size":500,"total_number":3,"total_page":2}}`
+		case 2:
+			data = `{"list":[{"bw_content":"spam"}],"page_info":{"page":2,"page_size":500,"total_number":3,"total_page":2}}`
+		default:
+			t.Fatalf("unexpected page %d", pageInfo["page"])
+		}
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	keywords, err := api.GetCommentBlockKeywords(context.Background(), "123456")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"scam", "fake", "spam"}, keywords)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAddCommentBlockKeywords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/blockedword/create/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "123456", body["advertiser_id"])
+		assert.Equal(t, []interface{}{"scam", "fake"}, body["blocked_words"])
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.AddCommentBlockKeywords(context.Background(), "123456", []string{"scam", "fake"})
+	require.NoError(t, err)
+}
+
+func TestAddCommentBlockKeywords_Empty(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	err := api.AddCommentBlockKeywords(context.Background(), "123456", nil)
+	assert.Error(t, err)
+}
+
+// Helper functions
+func ptrInt64(i int64) *int64 {
+	return &i
+}
@@ -2,8 +2,12 @@ package tiktok
 
 import (
 	"encoding/json"
+	"errors"
 )
 
+// ErrNilRequest is returned when an API method is called with a nil request
+var ErrNilRequest = errors.New("nil request")
+
 // Response represents the common response structure for TikTok Business API
 type Response struct {
 	Code      *int64          `json:"code,omitempty"`
@@ -17,6 +21,8 @@ type ErrorResponse struct {
 	Code      int64  `json:"code"`
 	Message   string `json:"message"`
 	RequestID string `json:"request_id"`
+	// LogID is the X-Tt-Logid response header, which TikTok support can trace
+	LogID string `json:"log_id"`
 }
 
 // Error implements the error interface
@@ -39,6 +45,23 @@ func DefaultConfig() *ClientConfig {
 	}
 }
 
+// Primary status filter values
+const (
+	// PrimaryStatusNotDelete hides deleted entities
+	PrimaryStatusNotDelete = "STATUS_NOT_DELETE"
+	// PrimaryStatusDeliveryOK matches entities that are currently delivering
+	PrimaryStatusDeliveryOK = "STATUS_DELIVERY_OK"
+)
+
+// FilterTimeLayout is the layout of create_time_min and create_time_max filter values, in UTC
+const FilterTimeLayout = "2006-01-02 15:04:05"
+
+// Field types that can be passed in exclude_field_types_in_response
+const (
+	// ExcludeFieldTypeCostData omits cost and budget fields from list responses
+	ExcludeFieldTypeCostData = "COST_DATA"
+)
+
 // PageInfo represents common pagination information used across all API responses
 type PageInfo struct {
 	Page        int64 `json:"page"`
@@ -52,3 +75,19 @@ type PaginationParams struct {
 	Page     *int64
 	PageSize *int64
 }
+
+// Sort orders for OrderType
+const (
+	OrderTypeAsc  = "ASC"
+	OrderTypeDesc = "DESC"
+)
+
+// BaseListRequest holds the paging, field selection and sort options shared by list requests.
+// Module requests embed it and call Apply to add the options to the query.
+type BaseListRequest struct {
+	Page       *int64   `json:"page,omitempty"`
+	PageSize   *int64   `json:"page_size,omitempty"`
+	Fields     []string `json:"fields,omitempty"`
+	OrderField *string  `json:"order_field,omitempty"`
+	OrderType  *string  `json:"order_type,omitempty"`
+}
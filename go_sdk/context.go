@@ -0,0 +1,26 @@
+package tiktok
+
+import "context"
+
+type advertiserIDKey struct{}
+
+// WithAdvertiserID returns a copy of ctx carrying a default advertiser ID.
+// List and get methods fall back to it when the request leaves the advertiser ID empty.
+func WithAdvertiserID(ctx context.Context, advertiserID string) context.Context {
+	return context.WithValue(ctx, advertiserIDKey{}, advertiserID)
+}
+
+// AdvertiserIDFromContext returns the advertiser ID stored by WithAdvertiserID
+func AdvertiserIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(advertiserIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// ResolveAdvertiserID returns advertiserID if set, otherwise the advertiser ID stored in ctx
+func ResolveAdvertiserID(ctx context.Context, advertiserID string) string {
+	if advertiserID != "" {
+		return advertiserID
+	}
+	id, _ := AdvertiserIDFromContext(ctx)
+	return id
+}
@@ -0,0 +1,29 @@
+package tiktok
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvertiserIDFromContext(t *testing.T) {
+	_, ok := AdvertiserIDFromContext(context.Background())
+	assert.False(t, ok)
+
+	ctx := WithAdvertiserID(context.Background(), "adv-123")
+	id, ok := AdvertiserIDFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "adv-123", id)
+
+	_, ok = AdvertiserIDFromContext(WithAdvertiserID(context.Background(), ""))
+	assert.False(t, ok)
+}
+
+func TestResolveAdvertiserID(t *testing.T) {
+	ctx := WithAdvertiserID(context.Background(), "adv-ctx")
+
+	assert.Equal(t, "adv-explicit", ResolveAdvertiserID(ctx, "adv-explicit"))
+	assert.Equal(t, "adv-ctx", ResolveAdvertiserID(ctx, ""))
+	assert.Equal(t, "", ResolveAdvertiserID(context.Background(), ""))
+}
@@ -0,0 +1,155 @@
+package creative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// AssetVideoInfo is the video of an ACO media asset
+type AssetVideoInfo struct {
+	VideoID  string `json:"video_id"`
+	FileName string `json:"file_name,omitempty"`
+}
+
+// AssetImageInfo is an image of an ACO media asset, or the cover when the asset is a video
+type AssetImageInfo struct {
+	WebURI   string `json:"web_uri"`
+	FileName string `json:"file_name,omitempty"`
+}
+
+// CreativeAsset is one media asset (video, images or Spark Ads post) of an ACO ad group
+type CreativeAsset struct {
+	IdentityID   string           `json:"identity_id,omitempty"`
+	IdentityType string           `json:"identity_type,omitempty"`
+	TiktokItemID string           `json:"tiktok_item_id,omitempty"`
+	VideoInfo    *AssetVideoInfo  `json:"video_info,omitempty"`
+	ImageInfo    []AssetImageInfo `json:"image_info,omitempty"`
+}
+
+// AssetMediaInfo is an entry of media_info_list
+type AssetMediaInfo struct {
+	MediaInfo CreativeAsset `json:"media_info"`
+}
+
+// AssetTitle is an entry of title_list
+type AssetTitle struct {
+	Title string `json:"title"`
+}
+
+// AssetCallToAction is an entry of call_to_action_list
+type AssetCallToAction struct {
+	CallToAction string `json:"call_to_action"`
+}
+
+// AssetDisplayName is an entry of display_name_list
+type AssetDisplayName struct {
+	AppName         string `json:"app_name,omitempty"`
+	LandingPageName string `json:"landing_page_name,omitempty"`
+}
+
+// AssetAvatarIcon is an entry of avatar_icon_list
+type AssetAvatarIcon struct {
+	AvatarIcon struct {
+		WebURI string `json:"web_uri"`
+	} `json:"avatar_icon"`
+}
+
+// AssetLandingPageURL is an entry of landing_page_urls
+type AssetLandingPageURL struct {
+	LandingPageURL string `json:"landing_page_url"`
+}
+
+// AssetDeeplink is an entry of deeplink_list
+type AssetDeeplink struct {
+	Deeplink     string `json:"deeplink"`
+	DeeplinkType string `json:"deeplink_type,omitempty"`
+}
+
+// AssetCard is an entry of card_list
+type AssetCard struct {
+	CardID string `json:"card_id"`
+}
+
+// AssetPage is an entry of page_list
+type AssetPage struct {
+	PageID string `json:"page_id"`
+}
+
+// AssetCommonMaterial holds the settings shared by every ad combined from an asset group
+type AssetCommonMaterial struct {
+	AdName             string `json:"ad_name,omitempty"`
+	IdentityID         string `json:"identity_id,omitempty"`
+	IdentityType       string `json:"identity_type,omitempty"`
+	CallToActionID     string `json:"call_to_action_id,omitempty"`
+	FallbackType       string `json:"fallback_type,omitempty"`
+	PlayableURL        string `json:"playable_url,omitempty"`
+	IsSmartCreative    bool   `json:"is_smart_creative,omitempty"`
+	CreativeAuthorized bool   `json:"creative_authorized,omitempty"`
+}
+
+// AssetGroup represents the creative materials that ACO combines into ads for an ad group
+type AssetGroup struct {
+	AdgroupID        string                `json:"adgroup_id"`
+	AdvertiserID     string                `json:"advertiser_id"`
+	MediaInfoList    []AssetMediaInfo      `json:"media_info_list,omitempty"`
+	TitleList        []AssetTitle          `json:"title_list,omitempty"`
+	CallToActionList []AssetCallToAction   `json:"call_to_action_list,omitempty"`
+	DisplayNameList  []AssetDisplayName    `json:"display_name_list,omitempty"`
+	AvatarIconList   []AssetAvatarIcon     `json:"avatar_icon_list,omitempty"`
+	LandingPageURLs  []AssetLandingPageURL `json:"landing_page_urls,omitempty"`
+	DeeplinkList     []AssetDeeplink       `json:"deeplink_list,omitempty"`
+	CardList         []AssetCard           `json:"card_list,omitempty"`
+	PageList         []AssetPage           `json:"page_list,omitempty"`
+	CommonMaterial   *AssetCommonMaterial  `json:"common_material,omitempty"`
+}
+
+// VideoIDs returns the IDs of the video assets in the group
+func (g *AssetGroup) VideoIDs() []string {
+	var ids []string
+	for _, media := range g.MediaInfoList {
+		if media.MediaInfo.VideoInfo != nil {
+			ids = append(ids, media.MediaInfo.VideoInfo.VideoID)
+		}
+	}
+	return ids
+}
+
+// Titles returns the ad texts in the group
+func (g *AssetGroup) Titles() []string {
+	titles := make([]string, 0, len(g.TitleList))
+	for _, title := range g.TitleList {
+		titles = append(titles, title.Title)
+	}
+	return titles
+}
+
+// GetCreativeAssetGroupsResponse represents the response for getting creative asset groups
+type GetCreativeAssetGroupsResponse struct {
+	List []AssetGroup `json:"list"`
+}
+
+// GetCreativeAssetGroups gets the creative materials of an ACO ad group
+// Reference: https://business-api.tiktok.com/portal/docs?id=1739473020978177
+func (a *API) GetCreativeAssetGroups(ctx context.Context, advertiserID, adgroupID string) (*GetCreativeAssetGroupsResponse, error) {
+	if adgroupID == "" {
+		return nil, errors.New("adgroup_id is required")
+	}
+
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
+
+	if err := tiktok.AddStringSlice(params, "adgroup_ids", []string{adgroupID}); err != nil {
+		return nil, err
+	}
+
+	var resp GetCreativeAssetGroupsResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/ad/aco/get/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get creative asset groups: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,95 @@
+package creative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetCreativeAssetGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/ad/aco/get/" {
+			t.Errorf("Expected path '/open_api/v1.3/ad/aco/get/', got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("adgroup_ids"); got != `["adgroup_001"]` {
+			t.Errorf(`Expected adgroup_ids '["adgroup_001"]', got %s`, got)
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"adgroup_id":    "adgroup_001",
+						"advertiser_id": "123456789",
+						"media_info_list": []map[string]interface{}{
+							{"media_info": map[string]interface{}{
+								"video_info": map[string]interface{}{"video_id": "video_001"},
+								"image_info": []map[string]interface{}{{"web_uri": "cover_001"}},
+							}},
+							{"media_info": map[string]interface{}{
+								"video_info": map[string]interface{}{"video_id": "video_002"},
+							}},
+							{"media_info": map[string]interface{}{
+								"image_info": []map[string]interface{}{{"web_uri": "image_001"}},
+							}},
+						},
+						"title_list":          []map[string]interface{}{{"title": "Buy now"}},
+						"call_to_action_list": []map[string]interface{}{{"call_to_action": "SHOP_NOW"}},
+						"common_material":     map[string]interface{}{"ad_name": "aco_ad", "identity_type": "CUSTOMIZED_USER"},
+					},
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_access_token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetCreativeAssetGroups(context.Background(), "123456789", "adgroup_001")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp.List) != 1 {
+		t.Fatalf("Expected 1 asset group, got %d", len(resp.List))
+	}
+
+	group := resp.List[0]
+	if group.AdgroupID != "adgroup_001" {
+		t.Errorf("Expected adgroup_id 'adgroup_001', got %s", group.AdgroupID)
+	}
+
+	if videos := group.VideoIDs(); len(videos) != 2 || videos[0] != "video_001" || videos[1] != "video_002" {
+		t.Errorf("Unexpected video IDs: %v", videos)
+	}
+	if cover := group.MediaInfoList[0].MediaInfo.ImageInfo; len(cover) != 1 || cover[0].WebURI != "cover_001" {
+		t.Errorf("Unexpected video cover: %+v", cover)
+	}
+	if titles := group.Titles(); len(titles) != 1 || titles[0] != "Buy now" {
+		t.Errorf("Unexpected ad texts: %v", titles)
+	}
+	if len(group.CallToActionList) != 1 || group.CallToActionList[0].CallToAction != "SHOP_NOW" {
+		t.Errorf("Unexpected call-to-actions: %+v", group.CallToActionList)
+	}
+	if group.CommonMaterial == nil || group.CommonMaterial.AdName != "aco_ad" {
+		t.Errorf("Unexpected common material: %+v", group.CommonMaterial)
+	}
+}
+
+func TestGetCreativeAssetGroups_MissingAdgroupID(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	if _, err := api.GetCreativeAssetGroups(context.Background(), "123456789", ""); err == nil {
+		t.Error("Expected error for empty adgroup ID")
+	}
+}
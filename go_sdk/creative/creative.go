@@ -55,11 +55,11 @@ type GetCreativesResponse struct {
 
 // GetCreativesRequest represents the request to get creatives
 type GetCreativesRequest struct {
+	tiktok.BaseListRequest
 	AdvertiserID string     `json:"advertiser_id"`
 	Filtering    *Filtering `json:"filtering,omitempty"`
-	Page         *int64     `json:"page,omitempty"`
-	PageSize     *int64     `json:"page_size,omitempty"`
-	Fields       []string   `json:"fields,omitempty"`
+	// ResolveAdStatus fills an empty OperationStatus from the creative's parent ad
+	ResolveAdStatus bool `json:"-"`
 }
 
 // Filtering represents filtering options for creatives
@@ -78,17 +78,15 @@ type Filtering struct {
 // GetCreatives gets creative information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740051721711618
 func (a *API) GetCreatives(ctx context.Context, req *GetCreativesRequest) (*GetCreativesResponse, error) {
-	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
 
-	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
-		Page:     req.Page,
-		PageSize: req.PageSize,
-	})
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
-	// Add fields using helper
-	if err := tiktok.AddStringSlice(params, "fields", req.Fields); err != nil {
+	// Add pagination, fields and sorting using helper
+	if err := req.Apply(a.client, params); err != nil {
 		return nil, err
 	}
 
@@ -103,37 +101,31 @@ func (a *API) GetCreatives(ctx context.Context, req *GetCreativesRequest) (*GetC
 		return nil, fmt.Errorf("failed to get creatives: %w", err)
 	}
 
+	if req.ResolveAdStatus {
+		if err := a.resolveAdStatus(ctx, tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID), resp.List); err != nil {
+			return nil, err
+		}
+	}
+
 	return &resp, nil
 }
 
 // GetAllCreatives retrieves all creatives by automatically handling pagination
 // This is a convenience method that calls GetCreatives multiple times if needed
 func (a *API) GetAllCreatives(ctx context.Context, req *GetCreativesRequest) ([]CreativeInfo, error) {
-	var allCreatives []CreativeInfo
-	page := int64(1)
-	pageSize := int64(100) // Use maximum page size for efficiency
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
 
-	// Override pagination parameters in request
-	req.Page = &page
-	req.PageSize = &pageSize
+	// Use maximum page size for efficiency
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]CreativeInfo, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
 
-	for {
 		resp, err := a.GetCreatives(ctx, req)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get creatives page %d: %w", page, err)
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to get creatives page %d: %w", page, err)
 		}
-
-		allCreatives = append(allCreatives, resp.List...)
-
-		// Check if we've fetched all pages
-		if page >= resp.PageInfo.TotalPage {
-			break
-		}
-
-		// Move to next page
-		page++
-		req.Page = &page
-	}
-
-	return allCreatives, nil
+		return resp.List, resp.PageInfo, nil
+	})
 }
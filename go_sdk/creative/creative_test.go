@@ -76,9 +76,8 @@ func TestGetCreatives(t *testing.T) {
 	page := int64(1)
 	pageSize := int64(10)
 	req := &GetCreativesRequest{
-		AdvertiserID: "123456789",
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    "123456789",
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	}
 
 	resp, err := api.GetCreatives(context.Background(), req)
@@ -190,3 +189,93 @@ func TestGetAllCreatives(t *testing.T) {
 		t.Errorf("Expected second creative_id 'creative_002', got %s", creatives[1].CreativeID)
 	}
 }
+
+func TestGetCreatives_ResolveAdStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data string
+		switch r.URL.Path {
+		case "/open_api/v1.3/creative/get/":
+			data = `{
+				"list": [
+					{"creative_id": "c1", "ad_id": "ad_001", "advertiser_id": "123456789"},
+					{"creative_id": "c2", "ad_id": "ad_002", "advertiser_id": "123456789"},
+					{"creative_id": "c3", "ad_id": "ad_001", "advertiser_id": "123456789"},
+					{"creative_id": "c4", "ad_id": "ad_003", "advertiser_id": "123456789", "operation_status": "DISABLE"}
+				],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 4, "total_page": 1}
+			}`
+		case "/open_api/v1.3/ad/get/":
+			if got := r.URL.Query().Get("advertiser_id"); got != "123456789" {
+				t.Errorf("Expected advertiser_id '123456789', got %s", got)
+			}
+			if got := r.URL.Query().Get("filtering"); got != `{"ad_ids":["ad_001","ad_002"]}` {
+				t.Errorf("Unexpected ad filtering %s", got)
+			}
+			data = `{
+				"list": [
+					{"ad_id": "ad_001", "operation_status": "ENABLE"},
+					{"ad_id": "ad_002", "operation_status": "DISABLE"}
+				],
+				"page_info": {"page": 1, "page_size": 100, "total_number": 2, "total_page": 1}
+			}`
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data":    json.RawMessage(data),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetCreatives(context.Background(), &GetCreativesRequest{
+		AdvertiserID:    "123456789",
+		Filtering:       &Filtering{AdIDs: []string{"ad_001", "ad_002", "ad_003"}},
+		ResolveAdStatus: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := map[string]string{"c1": "ENABLE", "c2": "DISABLE", "c3": "ENABLE", "c4": "DISABLE"}
+	for _, c := range resp.List {
+		if c.OperationStatus != want[c.CreativeID] {
+			t.Errorf("Expected creative %s status %s, got %s", c.CreativeID, want[c.CreativeID], c.OperationStatus)
+		}
+	}
+}
+
+func TestGetCreatives_WithoutResolveAdStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/creative/get/" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data":    json.RawMessage(`{"list":[{"creative_id":"c1","ad_id":"ad_001"}],"page_info":{}}`),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetCreatives(context.Background(), &GetCreativesRequest{AdvertiserID: "123456789"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.List[0].OperationStatus != "" {
+		t.Errorf("Expected empty status, got %s", resp.List[0].OperationStatus)
+	}
+}
@@ -0,0 +1,21 @@
+package creative
+
+// FormatUnknown is the GroupCreativesByFormat key for creatives with neither an ad format nor a creative type
+const FormatUnknown = "UNKNOWN"
+
+// GroupCreativesByFormat groups creatives by AdFormat, falling back to CreativeType
+// for creatives without one. Creatives keep their order within each group.
+func GroupCreativesByFormat(creatives []CreativeInfo) map[string][]CreativeInfo {
+	groups := make(map[string][]CreativeInfo)
+	for _, c := range creatives {
+		format := c.AdFormat
+		if format == "" {
+			format = c.CreativeType
+		}
+		if format == "" {
+			format = FormatUnknown
+		}
+		groups[format] = append(groups[format], c)
+	}
+	return groups
+}
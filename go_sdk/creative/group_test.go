@@ -0,0 +1,46 @@
+package creative
+
+import (
+	"testing"
+)
+
+func TestGroupCreativesByFormat(t *testing.T) {
+	creatives := []CreativeInfo{
+		{CreativeID: "c1", AdFormat: "SINGLE_VIDEO"},
+		{CreativeID: "c2", AdFormat: "SINGLE_IMAGE"},
+		{CreativeID: "c3", AdFormat: "SINGLE_VIDEO", CreativeType: "CUSTOM"},
+		{CreativeID: "c4", CreativeType: "CAROUSEL_ADS"},
+		{CreativeID: "c5"},
+	}
+
+	groups := GroupCreativesByFormat(creatives)
+
+	expected := map[string][]string{
+		"SINGLE_VIDEO": {"c1", "c3"},
+		"SINGLE_IMAGE": {"c2"},
+		"CAROUSEL_ADS": {"c4"},
+		FormatUnknown:  {"c5"},
+	}
+	if len(groups) != len(expected) {
+		t.Fatalf("Expected %d groups, got %d", len(expected), len(groups))
+	}
+	for format, ids := range expected {
+		group := groups[format]
+		if len(group) != len(ids) {
+			t.Errorf("Expected %d creatives in %s, got %d", len(ids), format, len(group))
+			continue
+		}
+		for i, id := range ids {
+			if group[i].CreativeID != id {
+				t.Errorf("Expected %s[%d] to be %s, got %s", format, i, id, group[i].CreativeID)
+			}
+		}
+	}
+}
+
+func TestGroupCreativesByFormat_Empty(t *testing.T) {
+	groups := GroupCreativesByFormat(nil)
+	if len(groups) != 0 {
+		t.Errorf("Expected no groups, got %d", len(groups))
+	}
+}
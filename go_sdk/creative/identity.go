@@ -0,0 +1,53 @@
+package creative
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// Identity types
+const (
+	IdentityTypeCustomizedUser = "CUSTOMIZED_USER"
+	IdentityTypeAuthCode       = "AUTH_CODE"
+	IdentityTypeTTUser         = "TT_USER"
+	IdentityTypeBCAuthTT       = "BC_AUTH_TT"
+)
+
+// IdentityInfo represents an identity that ads can be published under
+type IdentityInfo struct {
+	IdentityID             string `json:"identity_id"`
+	IdentityType           string `json:"identity_type"`
+	DisplayName            string `json:"display_name,omitempty"`
+	ProfileImage           string `json:"profile_image,omitempty"`
+	IdentityAuthorizedBcID string `json:"identity_authorized_bc_id,omitempty"`
+	CanPullVideo           bool   `json:"can_pull_video,omitempty"`
+	CanPushVideo           bool   `json:"can_push_video,omitempty"`
+}
+
+// ListIdentitiesResponse represents the response for listing identities
+type ListIdentitiesResponse struct {
+	IdentityList []IdentityInfo  `json:"identity_list"`
+	PageInfo     tiktok.PageInfo `json:"page_info"`
+}
+
+// ListIdentities lists the identities configured for an advertiser.
+// identityType optionally restricts the result to one identity type.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1740218420781057
+func (a *API) ListIdentities(ctx context.Context, advertiserID string, identityType *string) (*ListIdentitiesResponse, error) {
+	params := url.Values{}
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
+
+	if identityType != nil {
+		params.Set("identity_type", *identityType)
+	}
+
+	var resp ListIdentitiesResponse
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/identity/get/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,117 @@
+package creative
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestListIdentities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/open_api/v1.3/identity/get/" {
+			t.Errorf("Expected path '/open_api/v1.3/identity/get/', got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("advertiser_id"); got != "123456789" {
+			t.Errorf("Expected advertiser_id '123456789', got %s", got)
+		}
+		if r.URL.Query().Has("identity_type") {
+			t.Errorf("Expected no identity_type, got %s", r.URL.Query().Get("identity_type"))
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"identity_list": []map[string]interface{}{
+					{
+						"identity_id":   "id_001",
+						"identity_type": "CUSTOMIZED_USER",
+						"display_name":  "Brand",
+						"profile_image": "https://example.com/brand.png",
+					},
+					{
+						"identity_id":    "id_002",
+						"identity_type":  "AUTH_CODE",
+						"display_name":   "creator",
+						"profile_image":  "https://example.com/creator.png",
+						"can_pull_video": true,
+					},
+				},
+				"page_info": map[string]interface{}{
+					"page":         1,
+					"page_size":    10,
+					"total_number": 2,
+					"total_page":   1,
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_access_token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.ListIdentities(context.Background(), "123456789", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(resp.IdentityList) != 2 {
+		t.Fatalf("Expected 2 identities, got %d", len(resp.IdentityList))
+	}
+
+	custom := resp.IdentityList[0]
+	if custom.IdentityType != IdentityTypeCustomizedUser || custom.DisplayName != "Brand" {
+		t.Errorf("Unexpected customized user identity: %+v", custom)
+	}
+	if custom.ProfileImage != "https://example.com/brand.png" {
+		t.Errorf("Expected profile image, got %s", custom.ProfileImage)
+	}
+
+	authCode := resp.IdentityList[1]
+	if authCode.IdentityType != IdentityTypeAuthCode || authCode.IdentityID != "id_002" {
+		t.Errorf("Unexpected auth code identity: %+v", authCode)
+	}
+	if !authCode.CanPullVideo {
+		t.Error("Expected CanPullVideo to be true")
+	}
+}
+
+func TestListIdentities_TypeFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("identity_type"); got != "AUTH_CODE" {
+			t.Errorf("Expected identity_type 'AUTH_CODE', got %s", got)
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"identity_list": []map[string]interface{}{},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_access_token", server.URL, nil)
+	api := NewAPI(client)
+
+	identityType := IdentityTypeAuthCode
+	resp, err := api.ListIdentities(context.Background(), "123456789", &identityType)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.IdentityList) != 0 {
+		t.Errorf("Expected no identities, got %d", len(resp.IdentityList))
+	}
+}
@@ -0,0 +1,80 @@
+package creative
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// maxAdIDsPerRequest is the ad_ids filter limit of the ad get endpoint
+const maxAdIDsPerRequest = 100
+
+// adStatus holds the fields of an ad needed to resolve creative status
+type adStatus struct {
+	AdID            string `json:"ad_id"`
+	OperationStatus string `json:"operation_status"`
+}
+
+// resolveAdStatus fills empty OperationStatus values from each creative's parent ad
+func (a *API) resolveAdStatus(ctx context.Context, advertiserID string, creatives []CreativeInfo) error {
+	var adIDs []string
+	seen := make(map[string]bool)
+	for _, c := range creatives {
+		if c.OperationStatus == "" && c.AdID != "" && !seen[c.AdID] {
+			seen[c.AdID] = true
+			adIDs = append(adIDs, c.AdID)
+		}
+	}
+	if len(adIDs) == 0 {
+		return nil
+	}
+
+	statuses := make(map[string]string, len(adIDs))
+	for start := 0; start < len(adIDs); start += maxAdIDsPerRequest {
+		end := start + maxAdIDsPerRequest
+		if end > len(adIDs) {
+			end = len(adIDs)
+		}
+
+		ads, err := a.getAdStatuses(ctx, advertiserID, adIDs[start:end])
+		if err != nil {
+			return err
+		}
+		for _, ad := range ads {
+			statuses[ad.AdID] = ad.OperationStatus
+		}
+	}
+
+	for i := range creatives {
+		if creatives[i].OperationStatus == "" {
+			creatives[i].OperationStatus = statuses[creatives[i].AdID]
+		}
+	}
+	return nil
+}
+
+// getAdStatuses fetches the operation status of up to maxAdIDsPerRequest ads
+func (a *API) getAdStatuses(ctx context.Context, advertiserID string, adIDs []string) ([]adStatus, error) {
+	params := url.Values{}
+	params.Set("advertiser_id", advertiserID)
+	params.Set("page_size", strconv.Itoa(maxAdIDsPerRequest))
+
+	if err := tiktok.AddStringSlice(params, "fields", []string{"ad_id", "operation_status"}); err != nil {
+		return nil, err
+	}
+	if err := tiktok.AddJSONParam(params, "filtering", map[string][]string{"ad_ids": adIDs}); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		List []adStatus `json:"list"`
+	}
+	if err := tiktok.DoGet(ctx, a.client, "/open_api/v1.3/ad/get/", params, &resp); err != nil {
+		return nil, fmt.Errorf("failed to resolve parent ad status: %w", err)
+	}
+
+	return resp.List, nil
+}
@@ -0,0 +1,40 @@
+package tiktok
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldDelta describes a field whose value differs between two snapshots of an entity
+type FieldDelta struct {
+	// Field is the JSON name of the field (e.g. "budget")
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// DiffFields compares two values of the same struct type field by field and returns
+// the fields that differ, in declaration order. Fields without a JSON name are skipped.
+// Nil or mismatched values have no field-by-field diff and return nil.
+func DiffFields(oldValue, newValue interface{}) []FieldDelta {
+	ov := reflect.Indirect(reflect.ValueOf(oldValue))
+	nv := reflect.Indirect(reflect.ValueOf(newValue))
+	if !nv.IsValid() || ov.Kind() != reflect.Struct || ov.Type() != nv.Type() {
+		return nil
+	}
+
+	var deltas []FieldDelta
+	t := ov.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		o, n := ov.Field(i).Interface(), nv.Field(i).Interface()
+		if !reflect.DeepEqual(o, n) {
+			deltas = append(deltas, FieldDelta{Field: name, Old: o, New: n})
+		}
+	}
+	return deltas
+}
@@ -0,0 +1,39 @@
+package tiktok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffFields(t *testing.T) {
+	type entity struct {
+		ID     string   `json:"id"`
+		Name   string   `json:"name,omitempty"`
+		Tags   []string `json:"tags"`
+		Hidden string   `json:"-"`
+	}
+
+	deltas := DiffFields(
+		entity{ID: "1", Name: "a", Tags: []string{"x"}, Hidden: "old"},
+		&entity{ID: "1", Name: "b", Tags: []string{"x", "y"}, Hidden: "new"},
+	)
+
+	assert.Equal(t, []FieldDelta{
+		{Field: "name", Old: "a", New: "b"},
+		{Field: "tags", Old: []string{"x"}, New: []string{"x", "y"}},
+	}, deltas)
+}
+
+func TestDiffFields_MismatchedTypes(t *testing.T) {
+	assert.Nil(t, DiffFields(PageInfo{}, PaginationParams{}))
+	assert.Nil(t, DiffFields("a", "b"))
+}
+
+func TestDiffFields_NilValues(t *testing.T) {
+	var missing *PageInfo
+	assert.Nil(t, DiffFields(PageInfo{}, nil))
+	assert.Nil(t, DiffFields(PageInfo{}, missing))
+	assert.Nil(t, DiffFields(nil, PageInfo{}))
+	assert.Nil(t, DiffFields(missing, PageInfo{}))
+}
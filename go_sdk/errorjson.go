@@ -0,0 +1,33 @@
+package tiktok
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// logIDHeader is the response header carrying the TikTok log ID of a request
+const logIDHeader = "X-Tt-Logid"
+
+// MarshalJSON encodes the error as {code, message, request_id, log_id}
+func (e *ErrorResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code      int64  `json:"code"`
+		Message   string `json:"message"`
+		RequestID string `json:"request_id"`
+		LogID     string `json:"log_id"`
+	}{e.Code, e.Message, e.RequestID, e.LogID})
+}
+
+// AsJSON encodes the first *ErrorResponse in err's chain as JSON, for tools that
+// report errors in machine-readable form. It returns false if err has no API error.
+func AsJSON(err error) ([]byte, bool) {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		return nil, false
+	}
+	data, marshalErr := json.Marshal(errResp)
+	if marshalErr != nil {
+		return nil, false
+	}
+	return data, true
+}
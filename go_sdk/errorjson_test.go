@@ -0,0 +1,54 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(&ErrorResponse{
+		Code:      40002,
+		Message:   "Invalid parameter",
+		RequestID: "request-123",
+		LogID:     "log-456",
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code":40002,"message":"Invalid parameter","request_id":"request-123","log_id":"log-456"}`, string(data))
+}
+
+func TestAsJSON_Wrapped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Tt-Logid", "log-789")
+		json.NewEncoder(w).Encode(Response{
+			Code:      ptrInt64(40100),
+			Message:   ptrString("Access token expired"),
+			RequestID: ptrString("request-456"),
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.Error(t, err)
+
+	data, ok := AsJSON(fmt.Errorf("failed to get campaigns: %w", err))
+	require.True(t, ok)
+	assert.JSONEq(t, `{"code":40100,"message":"Access token expired","request_id":"request-456","log_id":"log-789"}`, string(data))
+}
+
+func TestAsJSON_NotAPIError(t *testing.T) {
+	data, ok := AsJSON(errors.New("connection refused"))
+	assert.False(t, ok)
+	assert.Nil(t, data)
+
+	_, ok = AsJSON(nil)
+	assert.False(t, ok)
+}
@@ -27,7 +27,7 @@ func main() {
 	// Get advertiser info
 	ctx := context.Background()
 	advertiserIDs := []string{advertiserID}
-	fields := []string{} // Empty means return all fields
+	fields := []string{} // Empty means account.DefaultAdvertiserFields()
 
 	resp, err := accountAPI.GetAdvertiserInfo(ctx, advertiserIDs, fields)
 	if err != nil {
@@ -42,6 +42,8 @@ func main() {
 		fmt.Printf("Status: %s\n", adv.Status)
 		fmt.Printf("Currency: %s\n", adv.Currency)
 		fmt.Printf("Balance: %.2f\n", adv.Balance)
+		fmt.Printf("Spend Cap: %.2f\n", adv.SpendCap.Float64())
+		fmt.Printf("Frozen Amount: %.2f\n", adv.FrozenAmount.Float64())
 		fmt.Printf("Timezone: %s\n", adv.Timezone)
 		fmt.Printf("Display Timezone: %s\n", adv.DisplayTimezone)
 	}
@@ -31,7 +31,7 @@ func main() {
 	}
 
 	fmt.Printf("Access Token: %s\n", resp.AccessToken)
-	fmt.Printf("Advertiser IDs: %v\n", resp.AdvertiserIDs)
+	fmt.Printf("Advertiser IDs: %v\n", resp.AllAdvertiserIDs())
 	fmt.Printf("Expires In: %d seconds (24 hours)\n", resp.ExpiresIn)
 	fmt.Printf("Refresh Token: %s\n", resp.RefreshToken)
 	fmt.Printf("Refresh Token Expires In: %d seconds (1 year)\n", resp.RefreshTokenExpiresIn)
@@ -31,9 +31,8 @@ func main() {
 	page := int64(1)
 	pageSize := int64(10)
 	req := &campaign.GetCampaignRequest{
-		AdvertiserID: advertiserID,
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    advertiserID,
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	}
 
 	resp, err := campaignAPI.GetCampaigns(ctx, req)
@@ -32,9 +32,8 @@ func main() {
 	page := int64(1)
 	pageSize := int64(10)
 	resp, err := creativeAPI.GetCreatives(ctx, &creative.GetCreativesRequest{
-		AdvertiserID: advertiserID,
-		Page:         &page,
-		PageSize:     &pageSize,
+		AdvertiserID:    advertiserID,
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	})
 	if err != nil {
 		log.Printf("Warning: Failed to get creatives: %v", err)
@@ -58,8 +57,7 @@ func main() {
 		Filtering: &creative.Filtering{
 			CreativeType: &creativeType,
 		},
-		Page:     &page,
-		PageSize: &pageSize,
+		BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
 	})
 	if err != nil {
 		log.Fatalf("Failed to get filtered creatives: %v", err)
@@ -80,7 +80,7 @@ func main() {
 	budget := 2000.0 // Minimum daily budget for JPY
 	bidPrice := 10.0 // Minimum bid price for JPY
 	scheduleType := "SCHEDULE_FROM_NOW"
-	scheduleStartTime := time.Now().Format("2006-01-02 15:04:05")
+	scheduleStartTime := adgroup.ScheduleTime(time.Now())
 	pacing := "PACING_MODE_SMOOTH"
 
 	adgroupReq := &adgroup.CreateAdGroupRequest{
@@ -88,7 +88,7 @@ func main() {
 		CampaignID:        campaignResp.CampaignID,
 		AdGroupName:       adgroupName,
 		PromotionType:     &promotionType,
-		PlacementType:     "PLACEMENT_TYPE_AUTOMATIC",
+		PlacementType:     adgroup.PlacementTypeNormal,
 		Placements:        []string{"PLACEMENT_TIKTOK"},
 		LocationIDs:       []string{"6252001"}, // Japan
 		BudgetMode:        "BUDGET_MODE_DAY",
@@ -51,21 +51,10 @@ func main() {
 		endDate = "2024-01-31"
 	}
 
-	serviceType := "AUCTION"
-	dataLevel := "AUCTION_CAMPAIGN"
-
-	req := &reporting.IntegratedGetRequest{
-		ReportType:   "BASIC",
-		AdvertiserID: &advertiserID,
-		ServiceType:  &serviceType,
-		DataLevel:    &dataLevel,
-		Dimensions:   []string{"campaign_id", "stat_time_day"},
-		Metrics:      metrics,
-		StartDate:    &startDate,
-		EndDate:      &endDate,
-	}
-
-	resp, err := reportingAPI.GetIntegratedReport(ctx, req)
+	resp, err := reportingAPI.CampaignReport(ctx, advertiserID, reporting.DateRange{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, metrics)
 	if err != nil {
 		log.Fatalf("Failed to get report: %v", err)
 	}
@@ -89,13 +78,14 @@ func main() {
 	campaignID := os.Getenv("TIKTOK_CAMPAIGN_ID")
 	if campaignID != "" {
 		fmt.Println("\n=== Getting Campaign-specific Report ===")
-		filtering := map[string]interface{}{
-			"campaign_ids": []string{campaignID},
+		filtering := reporting.ReportFiltering{
+			CampaignIDs: []string{campaignID},
 		}
 
-		adDataLevel := "AUCTION_AD"
+		serviceType := reporting.ServiceTypeAuction
+		adDataLevel := reporting.DataLevelAd
 		reqFiltered := &reporting.IntegratedGetRequest{
-			ReportType:   "BASIC",
+			ReportType:   reporting.ReportTypeBasic,
 			AdvertiserID: &advertiserID,
 			ServiceType:  &serviceType,
 			DataLevel:    &adDataLevel,
@@ -148,9 +148,9 @@ func main() {
 	// Example 6: Get detailed ad information
 	fmt.Println("Example 6: Get detailed ad information with specific fields")
 	fields := []string{
-		"ad_id", "ad_name", "advertiser_name", "ad_text",
-		"impressions", "clicks", "ctr", "video_id", "video_title",
-		"landing_page_url", "first_shown_date", "last_shown_date",
+		research.FieldAdID, research.FieldAdName, research.FieldAdvertiserName, research.FieldAdText,
+		research.FieldImpressions, research.FieldClicks, research.FieldCTR, research.FieldVideoID, research.FieldVideoTitle,
+		research.FieldLandingPageURL, research.FieldFirstShownDate, research.FieldLastShownDate,
 	}
 
 	respDetailed, err := researchAPI.GetAdReport(ctx, &research.GetAdReportRequest{
@@ -0,0 +1,95 @@
+package tiktok
+
+import (
+	"reflect"
+	"strings"
+)
+
+// EmptyRequestedFields returns the requested fields that are empty in every item of result.
+// result is a slice of structs, pointers to structs or maps, a pointer to one, or a list
+// response with a "list" field. Struct fields are matched by JSON name, so a requested
+// field the item type does not declare is always reported. An empty result reports nothing.
+func EmptyRequestedFields(requested []string, result interface{}) []string {
+	items := listItems(reflect.ValueOf(result))
+	if !items.IsValid() || items.Len() == 0 {
+		return nil
+	}
+
+	var empty []string
+	for _, field := range requested {
+		found := false
+		for i := 0; i < items.Len(); i++ {
+			if v := itemField(items.Index(i), field); !isEmptyValue(v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			empty = append(empty, field)
+		}
+	}
+	return empty
+}
+
+// WarnOnEmptyRequestedFields logs the requested fields that came back empty for every
+// item of result, which usually means a misspelled field name or a missing permission.
+// It is meant as a debugging aid after list calls that set Fields.
+func (c *Client) WarnOnEmptyRequestedFields(requested []string, result interface{}) {
+	if empty := EmptyRequestedFields(requested, result); len(empty) > 0 {
+		c.logf("tiktok: requested fields are empty for every item: %s", strings.Join(empty, ", "))
+	}
+}
+
+// listItems returns the slice held by v, following pointers and a "list" struct field
+func listItems(v reflect.Value) reflect.Value {
+	v = reflect.Indirect(v)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return v
+	case reflect.Struct:
+		if i := jsonFieldIndex(v.Type(), "list"); i >= 0 {
+			return listItems(v.Field(i))
+		}
+	}
+	return reflect.Value{}
+}
+
+// itemField returns the value of the field with the given JSON name, or an invalid value
+func itemField(item reflect.Value, name string) reflect.Value {
+	for item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface {
+		if item.IsNil() {
+			return reflect.Value{}
+		}
+		item = item.Elem()
+	}
+	switch item.Kind() {
+	case reflect.Struct:
+		if i := jsonFieldIndex(item.Type(), name); i >= 0 {
+			return item.Field(i)
+		}
+	case reflect.Map:
+		if item.Type().Key().Kind() == reflect.String {
+			return item.MapIndex(reflect.ValueOf(name).Convert(item.Type().Key()))
+		}
+	}
+	return reflect.Value{}
+}
+
+// isEmptyValue reports whether v is missing or the zero value of its type. Interface
+// values, such as the values of a map[string]interface{}, are judged by what they hold.
+func isEmptyValue(v reflect.Value) bool {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	return !v.IsValid() || v.IsZero()
+}
+
+// jsonFieldIndex returns the index of the field of t with the given JSON name, or -1
+func jsonFieldIndex(t reflect.Type, name string) int {
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,63 @@
+package tiktok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldsItem struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Budget float64  `json:"budget"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+func TestEmptyRequestedFields(t *testing.T) {
+	items := []fieldsItem{
+		{ID: "1", Budget: 10},
+		{ID: "2", Tags: []string{"a"}},
+	}
+
+	empty := EmptyRequestedFields([]string{"id", "name", "budget", "tags", "buget"}, items)
+	assert.Equal(t, []string{"name", "buget"}, empty)
+}
+
+func TestEmptyRequestedFields_ListResponse(t *testing.T) {
+	resp := &struct {
+		List     []*fieldsItem `json:"list"`
+		PageInfo PageInfo      `json:"page_info"`
+	}{
+		List: []*fieldsItem{{ID: "1"}, nil},
+	}
+
+	assert.Equal(t, []string{"name"}, EmptyRequestedFields([]string{"id", "name"}, resp))
+}
+
+func TestEmptyRequestedFields_Maps(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"spend": "1.00", "clicks": nil, "ctr": ""},
+		{"spend": "2.00", "ctr": 0},
+	}
+
+	assert.Equal(t, []string{"clicks", "ctr"}, EmptyRequestedFields([]string{"spend", "clicks", "ctr"}, rows))
+}
+
+func TestEmptyRequestedFields_NoItems(t *testing.T) {
+	assert.Nil(t, EmptyRequestedFields([]string{"name"}, []fieldsItem{}))
+	assert.Nil(t, EmptyRequestedFields([]string{"name"}, nil))
+}
+
+func TestWarnOnEmptyRequestedFields(t *testing.T) {
+	logger := &recordingLogger{}
+	client := NewClient("test-token", WithLogger(logger))
+
+	client.WarnOnEmptyRequestedFields([]string{"id", "name"}, []fieldsItem{{ID: "1"}, {ID: "2"}})
+
+	require.Len(t, logger.lines, 1)
+	assert.Equal(t, "tiktok: requested fields are empty for every item: name", logger.lines[0])
+
+	client.WarnOnEmptyRequestedFields([]string{"id"}, []fieldsItem{{ID: "1"}})
+	assert.Len(t, logger.lines, 1)
+}
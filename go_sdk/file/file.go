@@ -58,6 +58,10 @@ type GetVideoInfoRequest struct {
 // GetVideoInfo gets video information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740050161973250
 func (a *API) GetVideoInfo(ctx context.Context, req *GetVideoInfoRequest) (*GetVideoInfoResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	if len(req.VideoIDs) == 0 {
 		return nil, fmt.Errorf("video_ids cannot be empty")
 	}
@@ -67,7 +71,7 @@ func (a *API) GetVideoInfo(ctx context.Context, req *GetVideoInfoRequest) (*GetV
 	}
 
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
 	// Add video IDs using helper
 	if err := tiktok.AddStringSlice(params, "video_ids", req.VideoIDs); err != nil {
@@ -86,6 +90,7 @@ func (a *API) GetVideoInfo(ctx context.Context, req *GetVideoInfoRequest) (*GetV
 // VideoSearchFiltering represents filtering options for video search
 type VideoSearchFiltering struct {
 	VideoIDs      []string `json:"video_ids,omitempty"`
+	MaterialIDs   []string `json:"material_ids,omitempty"`
 	Width         *int64   `json:"width,omitempty"`
 	Height        *int64   `json:"height,omitempty"`
 	Ratio         []string `json:"ratio,omitempty"`
@@ -111,11 +116,15 @@ type SearchVideosResponse struct {
 // SearchVideos searches for video creatives in the Asset Library
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740050472410114
 func (a *API) SearchVideos(ctx context.Context, req *SearchVideosRequest) (*SearchVideosResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -143,6 +152,10 @@ type DownloadVideoRequest struct {
 
 // DownloadVideo downloads a video from the given URL to the specified path
 func (a *API) DownloadVideo(ctx context.Context, req *DownloadVideoRequest) error {
+	if req == nil {
+		return tiktok.ErrNilRequest
+	}
+
 	if req.URL == "" {
 		return fmt.Errorf("URL cannot be empty")
 	}
@@ -184,17 +197,38 @@ func (a *API) DownloadVideo(ctx context.Context, req *DownloadVideoRequest) erro
 	if err != nil {
 		return fmt.Errorf("failed to create output file: %w", err)
 	}
-	defer func() { _ = out.Close() }()
 
-	// Copy content to file
-	_, err = io.Copy(out, resp.Body)
+	// Copy content to file, stopping as soon as ctx is done
+	_, err = io.Copy(out, &contextReader{ctx: ctx, r: resp.Body})
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
+		// Don't leave a partial video behind
+		_ = os.Remove(outputFile)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
 		return fmt.Errorf("failed to write video to file: %w", err)
 	}
 
 	return nil
 }
 
+// contextReader is an io.Reader that fails with the context's error once it is done
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read implements io.Reader
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
+
 // ImageInfo represents image information
 type ImageInfo struct {
 	ImageID           string   `json:"image_id"`
@@ -225,6 +259,10 @@ type GetImageInfoRequest struct {
 // GetImageInfo gets image information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740051721711618
 func (a *API) GetImageInfo(ctx context.Context, req *GetImageInfoRequest) (*GetImageInfoResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	if len(req.ImageIDs) == 0 {
 		return nil, fmt.Errorf("image_ids cannot be empty")
 	}
@@ -234,7 +272,7 @@ func (a *API) GetImageInfo(ctx context.Context, req *GetImageInfoRequest) (*GetI
 	}
 
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
 	// Add image IDs using helper
 	if err := tiktok.AddStringSlice(params, "image_ids", req.ImageIDs); err != nil {
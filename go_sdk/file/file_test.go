@@ -2,9 +2,16 @@ package file
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
 )
 
@@ -93,3 +100,108 @@ func TestDownloadVideo_EmptyURL(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "URL cannot be empty")
 }
+
+func TestDownloadVideo_NilRequest(t *testing.T) {
+	client := tiktok.NewClient("test-token")
+	api := NewAPI(client)
+
+	err := api.DownloadVideo(context.Background(), nil)
+	assert.ErrorIs(t, err, tiktok.ErrNilRequest)
+}
+
+func TestDownloadVideo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("video-bytes"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	api := NewAPI(tiktok.NewClient("test-token"))
+
+	err := api.DownloadVideo(context.Background(), &DownloadVideoRequest{
+		URL:        server.URL,
+		OutputPath: dir,
+		FileName:   "out.mp4",
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "out.mp4"))
+	require.NoError(t, err)
+	assert.Equal(t, "video-bytes", string(data))
+}
+
+func TestDownloadVideo_CancelRemovesPartialFile(t *testing.T) {
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		w.(http.Flusher).Flush()
+		close(started)
+		// Stall mid-stream until the client goes away
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	api := NewAPI(tiktok.NewClient("test-token"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	err := api.DownloadVideo(ctx, &DownloadVideoRequest{
+		URL:        server.URL,
+		OutputPath: dir,
+		FileName:   "partial.mp4",
+	})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, statErr := os.Stat(filepath.Join(dir, "partial.mp4"))
+	assert.True(t, os.IsNotExist(statErr), "partial file should be removed")
+}
+
+func TestContextReader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cr := &contextReader{ctx: ctx, r: strings.NewReader("data")}
+
+	buf := make([]byte, 2)
+	n, err := cr.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	cancel()
+	_, err = cr.Read(buf)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetInfo_IDsAreJSONEncoded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/video/ad/info/":
+			assert.Equal(t, []string{`["v1","v2"]`}, r.URL.Query()["video_ids"])
+		case "/open_api/v1.3/file/image/ad/info/":
+			assert.Equal(t, []string{`["i1","i2"]`}, r.URL.Query()["image_ids"])
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		code := int64(0)
+		response := tiktok.Response{
+			Code: &code,
+			Data: json.RawMessage(`{"list":[]}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetVideoInfo(context.Background(), &GetVideoInfoRequest{AdvertiserID: "123", VideoIDs: []string{"v1", "v2"}})
+	require.NoError(t, err)
+
+	_, err = api.GetImageInfo(context.Background(), &GetImageInfoRequest{AdvertiserID: "123", ImageIDs: []string{"i1", "i2"}})
+	require.NoError(t, err)
+}
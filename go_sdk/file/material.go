@@ -0,0 +1,35 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrMaterialNotFound is returned when no video in the Asset Library has the material ID
+var ErrMaterialNotFound = errors.New("material not found")
+
+// GetAssetByMaterialID gets the Asset Library video with the given material ID, such as
+// the material_id dimension of a material report. Only videos are searched.
+func (a *API) GetAssetByMaterialID(ctx context.Context, advertiserID, materialID string) (*VideoInfo, error) {
+	if materialID == "" {
+		return nil, errors.New("material ID cannot be empty")
+	}
+
+	resp, err := a.SearchVideos(ctx, &SearchVideosRequest{
+		AdvertiserID: advertiserID,
+		Filtering:    &VideoSearchFiltering{MaterialIDs: []string{materialID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset for material %s: %w", materialID, err)
+	}
+
+	// Re-check locally in case the filter is ignored
+	for i := range resp.List {
+		if resp.List[i].MaterialID == materialID {
+			return &resp.List[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("material %s: %w", materialID, ErrMaterialNotFound)
+}
@@ -0,0 +1,74 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func newMaterialSearchServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/file/video/ad/search/", r.URL.Path)
+		assert.Equal(t, "adv-123", r.URL.Query().Get("advertiser_id"))
+
+		var filtering VideoSearchFiltering
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("filtering")), &filtering))
+		require.Len(t, filtering.MaterialIDs, 1)
+
+		videos := []map[string]interface{}{
+			{"video_id": "v-1", "material_id": "mat-1", "file_name": "first.mp4"},
+			{"video_id": "v-2", "material_id": "mat-2", "file_name": "second.mp4"},
+		}
+		var list []map[string]interface{}
+		for _, v := range videos {
+			if v["material_id"] == filtering.MaterialIDs[0] {
+				list = append(list, v)
+			}
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list":      list,
+				"page_info": map[string]interface{}{"page": 1, "page_size": 20, "total_number": len(list), "total_page": 1},
+			},
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestGetAssetByMaterialID(t *testing.T) {
+	server := newMaterialSearchServer(t)
+	defer server.Close()
+
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", server.URL, nil))
+
+	video, err := api.GetAssetByMaterialID(context.Background(), "adv-123", "mat-2")
+	require.NoError(t, err)
+	assert.Equal(t, "v-2", video.VideoID)
+	assert.Equal(t, "second.mp4", video.FileName)
+}
+
+func TestGetAssetByMaterialID_NotFound(t *testing.T) {
+	server := newMaterialSearchServer(t)
+	defer server.Close()
+
+	api := NewAPI(tiktok.NewClientWithConfig("test-token", server.URL, nil))
+
+	_, err := api.GetAssetByMaterialID(context.Background(), "adv-123", "mat-9")
+	assert.ErrorIs(t, err, ErrMaterialNotFound)
+}
+
+func TestGetAssetByMaterialID_EmptyMaterialID(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.GetAssetByMaterialID(context.Background(), "adv-123", "")
+	assert.Error(t, err)
+}
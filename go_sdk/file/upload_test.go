@@ -0,0 +1,94 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestUploadVideoAndWait(t *testing.T) {
+	infoCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch r.URL.Path {
+		case "/open_api/v1.3/file/video/ad/upload/":
+			assert.Equal(t, http.MethodPost, r.Method)
+			var body UploadVideoRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, UploadVideoRequest{
+				AdvertiserID: "adv-123",
+				UploadType:   VideoUploadTypeByURL,
+				VideoURL:     "https://example.com/video.mp4",
+			}, body)
+			data = []map[string]interface{}{{"video_id": "v-1"}}
+		case "/open_api/v1.3/file/video/ad/info/":
+			infoCalls++
+			assert.Equal(t, `["v-1"]`, r.URL.Query().Get("video_ids"))
+			video := map[string]interface{}{"video_id": "v-1"}
+			if infoCalls >= 2 {
+				video["allowed_placements"] = []string{"PLACEMENT_TIKTOK"}
+				video["preview_url"] = "https://example.com/preview.mp4"
+			}
+			data = map[string]interface{}{"list": []interface{}{video}}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": data}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	video, err := api.UploadVideoAndWait(context.Background(), &UploadVideoRequest{
+		AdvertiserID: "adv-123",
+		UploadType:   VideoUploadTypeByURL,
+		VideoURL:     "https://example.com/video.mp4",
+	}, time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, infoCalls)
+	assert.Equal(t, "v-1", video.VideoID)
+	assert.True(t, video.IsReady())
+	assert.Equal(t, "https://example.com/preview.mp4", video.PreviewURL)
+}
+
+func TestUploadVideoAndWait_ContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := `{"list":[{"video_id":"v-1"}]}`
+		if r.URL.Path == "/open_api/v1.3/file/video/ad/upload/" {
+			data = `[{"video_id":"v-1"}]`
+		}
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": json.RawMessage(data)}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := api.UploadVideoAndWait(ctx, &UploadVideoRequest{
+		AdvertiserID: "adv-123",
+		UploadType:   VideoUploadTypeByFileID,
+		FileID:       "file-1",
+	}, 5*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUploadVideoRequest_Validate(t *testing.T) {
+	assert.EqualError(t, (&UploadVideoRequest{UploadType: VideoUploadTypeByURL}).Validate(), "video URL is required for UPLOAD_BY_URL")
+	assert.EqualError(t, (&UploadVideoRequest{UploadType: VideoUploadTypeByFileID}).Validate(), "file ID is required for UPLOAD_BY_FILE_ID")
+	assert.EqualError(t, (&UploadVideoRequest{UploadType: "UPLOAD_BY_FILE"}).Validate(), `unsupported upload type "UPLOAD_BY_FILE"`)
+}
@@ -0,0 +1,92 @@
+package tiktok
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// FlexFloat64 is a float64 that also accepts JSON strings such as "1000.50".
+// Some endpoints return amounts as strings, others as numbers; empty strings and
+// null decode to zero.
+type FlexFloat64 float64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *FlexFloat64) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*f = 0
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*f = 0
+			return nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric string %q: %w", s, err)
+		}
+		*f = FlexFloat64(v)
+		return nil
+	}
+
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = FlexFloat64(v)
+	return nil
+}
+
+// Float64 returns the value as a float64
+func (f FlexFloat64) Float64() float64 {
+	return float64(f)
+}
+
+// FlexInt64 is an int64 that also accepts JSON strings such as "1024".
+// Report metrics are returned as strings; empty strings and null decode to zero,
+// and integral floats such as 1024.0 are accepted.
+type FlexInt64 int64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *FlexInt64) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*f = 0
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*f = 0
+			return nil
+		}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*f = FlexInt64(v)
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v != float64(int64(v)) {
+		return fmt.Errorf("invalid integer %s", data)
+	}
+	*f = FlexInt64(v)
+	return nil
+}
+
+// Int64 returns the value as an int64
+func (f FlexInt64) Int64() int64 {
+	return int64(f)
+}
@@ -0,0 +1,75 @@
+package tiktok
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlexFloat64_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  float64
+	}{
+		{"number", `1000.5`, 1000.5},
+		{"integer", `42`, 42},
+		{"string", `"1000.50"`, 1000.5},
+		{"empty string", `""`, 0},
+		{"null", `null`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f FlexFloat64
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &f))
+			assert.Equal(t, tt.want, f.Float64())
+		})
+	}
+}
+
+func TestFlexFloat64_UnmarshalInvalid(t *testing.T) {
+	var f FlexFloat64
+	assert.Error(t, json.Unmarshal([]byte(`"abc"`), &f))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &f))
+}
+
+func TestFlexFloat64_Marshal(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount FlexFloat64 `json:"amount"`
+	}{Amount: 12.5})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount":12.5}`, string(data))
+}
+
+func TestFlexInt64_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int64
+	}{
+		{"number", `1024`, 1024},
+		{"string", `"1024"`, 1024},
+		{"integral float", `1024.0`, 1024},
+		{"integral float string", `"1024.0"`, 1024},
+		{"empty string", `""`, 0},
+		{"null", `null`, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f FlexInt64
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &f))
+			assert.Equal(t, tt.want, f.Int64())
+		})
+	}
+}
+
+func TestFlexInt64_UnmarshalInvalid(t *testing.T) {
+	var f FlexInt64
+	assert.Error(t, json.Unmarshal([]byte(`"abc"`), &f))
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &f))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &f))
+}
@@ -0,0 +1,43 @@
+package tiktok
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FXConverter converts amount from one currency to another. The SDK has no exchange
+// rates of its own; callers supply a converter backed by their rate source.
+type FXConverter func(amount float64, from, to string) (float64, error)
+
+// ConvertTotals sums per-currency totals (such as those returned by
+// campaign.AggregateBudgets) into a single total in base. Totals already in base
+// are added as-is; totals under an empty currency cannot be converted and fail.
+func ConvertTotals(totals map[string]float64, base string, convert FXConverter) (float64, error) {
+	// Convert in a stable order so errors and float rounding are deterministic
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	var sum float64
+	for _, currency := range currencies {
+		amount := totals[currency]
+		if currency == base {
+			sum += amount
+			continue
+		}
+		if currency == "" {
+			return 0, fmt.Errorf("cannot convert %v with unknown currency to %s", amount, base)
+		}
+		if convert == nil {
+			return 0, fmt.Errorf("cannot convert %s to %s without an FX converter", currency, base)
+		}
+		converted, err := convert(amount, currency, base)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert %s to %s: %w", currency, base, err)
+		}
+		sum += converted
+	}
+	return sum, nil
+}
@@ -0,0 +1,44 @@
+package tiktok
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFX converts with fixed USD rates
+func stubFX(amount float64, from, to string) (float64, error) {
+	rates := map[string]float64{"USD": 1, "JPY": 0.0067, "EUR": 1.1}
+	fromRate, ok := rates[from]
+	if !ok {
+		return 0, errors.New("no rate for " + from)
+	}
+	toRate, ok := rates[to]
+	if !ok {
+		return 0, errors.New("no rate for " + to)
+	}
+	return amount * fromRate / toRate, nil
+}
+
+func TestConvertTotals(t *testing.T) {
+	total, err := ConvertTotals(map[string]float64{"USD": 350.5, "JPY": 10000}, "USD", stubFX)
+	require.NoError(t, err)
+	assert.InDelta(t, 417.5, total, 1e-9)
+}
+
+func TestConvertTotals_Errors(t *testing.T) {
+	_, err := ConvertTotals(map[string]float64{"USD": 1, "": 50}, "USD", stubFX)
+	assert.EqualError(t, err, "cannot convert 50 with unknown currency to USD")
+
+	_, err = ConvertTotals(map[string]float64{"GBP": 10}, "USD", stubFX)
+	assert.EqualError(t, err, "failed to convert GBP to USD: no rate for GBP")
+
+	_, err = ConvertTotals(map[string]float64{"JPY": 10}, "USD", nil)
+	assert.EqualError(t, err, "cannot convert JPY to USD without an FX converter")
+
+	total, err := ConvertTotals(map[string]float64{"USD": 10}, "USD", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, total)
+}
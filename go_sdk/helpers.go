@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 )
@@ -21,7 +22,56 @@ func AddPagination(params url.Values, pagination *PaginationParams) {
 	}
 }
 
-// AddJSONParam marshals value to JSON and adds it to params with the given key
+// WithDefaultPageSize sets the page size sent by list requests that leave PageSize unset.
+// A request's own PageSize always wins.
+func WithDefaultPageSize(pageSize int64) ClientOption {
+	return func(c *Client) {
+		c.defaultPageSize = pageSize
+	}
+}
+
+// AddPagination adds pagination parameters to url.Values,
+// falling back to the client's default page size when PageSize is unset
+func (c *Client) AddPagination(params url.Values, pagination *PaginationParams) {
+	if c.defaultPageSize > 0 && (pagination == nil || pagination.PageSize == nil) {
+		pageSize := c.defaultPageSize
+		p := PaginationParams{PageSize: &pageSize}
+		if pagination != nil {
+			p.Page = pagination.Page
+		}
+		pagination = &p
+	}
+	AddPagination(params, pagination)
+}
+
+// Apply adds the list options to params, using the client's default page size when PageSize is unset
+func (r *BaseListRequest) Apply(c *Client, params url.Values) error {
+	if r == nil {
+		r = &BaseListRequest{}
+	}
+
+	c.AddPagination(params, &PaginationParams{
+		Page:     r.Page,
+		PageSize: r.PageSize,
+	})
+
+	if err := AddStringSlice(params, "fields", r.Fields); err != nil {
+		return err
+	}
+
+	if r.OrderField != nil {
+		params.Set("order_field", *r.OrderField)
+	}
+	if r.OrderType != nil {
+		params.Set("order_type", *r.OrderType)
+	}
+
+	return nil
+}
+
+// AddJSONParam marshals value to JSON and adds it to params with the given key.
+// Nothing is added when value is nil, an empty string slice, or marshals to null
+// (e.g. a typed nil pointer or an empty filter).
 func AddJSONParam(params url.Values, key string, value interface{}) error {
 	if value == nil {
 		return nil
@@ -46,7 +96,8 @@ func AddJSONParam(params url.Values, key string, value interface{}) error {
 	return nil
 }
 
-// AddStringSlice adds a string slice as a JSON array parameter
+// AddStringSlice adds a string slice as a JSON array parameter (key=["a","b"]).
+// Most list parameters of GET endpoints are documented with this encoding.
 func AddStringSlice(params url.Values, key string, values []string) error {
 	if len(values) == 0 {
 		return nil
@@ -54,6 +105,14 @@ func AddStringSlice(params url.Values, key string, values []string) error {
 	return AddJSONParam(params, key, values)
 }
 
+// AddRepeatedParam adds each value as a separate parameter with the same key (key=a&key=b).
+// Use it only for endpoints whose spec declares a plain array parameter rather than a JSON array.
+func AddRepeatedParam(params url.Values, key string, values []string) {
+	for _, v := range values {
+		params.Add(key, v)
+	}
+}
+
 // DoGet executes a GET request and unmarshals the response into result
 // This is a generic helper that handles the common pattern of:
 // 1. Calling client.Get()
@@ -65,11 +124,11 @@ func DoGet[T any](ctx context.Context, client *Client, path string, params url.V
 		return err
 	}
 
-	if err := json.Unmarshal(resp.Data, result); err != nil {
-		return fmt.Errorf("failed to unmarshal response: %w", err)
+	if err := decodeData(resp.Data, result); err != nil {
+		return err
 	}
 
-	return nil
+	return client.validateResponse(path, resp.Data, result)
 }
 
 // DoPost executes a POST request and unmarshals the response into result
@@ -79,9 +138,36 @@ func DoPost[T any](ctx context.Context, client *Client, path string, body interf
 		return err
 	}
 
-	if err := json.Unmarshal(resp.Data, result); err != nil {
-		return fmt.Errorf("failed to unmarshal response: %w", err)
+	if err := decodeData(resp.Data, result); err != nil {
+		return err
+	}
+
+	return client.validateResponse(path, resp.Data, result)
+}
+
+// DoPostReader executes a POST request with a pre-serialized JSON body and unmarshals the response into result.
+// Use it for large payloads to avoid marshaling the whole body into memory.
+func DoPostReader[T any](ctx context.Context, client *Client, path string, body io.Reader, contentLength int64, result *T) error {
+	resp, err := client.PostReader(ctx, path, nil, body, contentLength)
+	if err != nil {
+		return err
 	}
 
+	if err := decodeData(resp.Data, result); err != nil {
+		return err
+	}
+
+	return client.validateResponse(path, resp.Data, result)
+}
+
+// decodeData unmarshals a response's data into result. Endpoints may return an object,
+// a list envelope or nothing at all; a missing or null data leaves result at its zero value.
+func decodeData(data json.RawMessage, result interface{}) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
 	return nil
 }
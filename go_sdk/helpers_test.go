@@ -3,9 +3,11 @@ package tiktok
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,6 +75,90 @@ func TestAddPagination(t *testing.T) {
 	})
 }
 
+func TestClient_AddPagination_DefaultPageSize(t *testing.T) {
+	client := NewClientWithConfig("test-token", "", nil, WithDefaultPageSize(500))
+
+	t.Run("default injected when page_size is absent", func(t *testing.T) {
+		params := url.Values{}
+		page := int64(3)
+
+		client.AddPagination(params, &PaginationParams{Page: &page})
+
+		assert.Equal(t, "3", params.Get("page"))
+		assert.Equal(t, "500", params.Get("page_size"))
+	})
+
+	t.Run("default injected for nil pagination", func(t *testing.T) {
+		params := url.Values{}
+
+		client.AddPagination(params, nil)
+
+		assert.Empty(t, params.Get("page"))
+		assert.Equal(t, "500", params.Get("page_size"))
+	})
+
+	t.Run("explicit page_size wins", func(t *testing.T) {
+		params := url.Values{}
+		pageSize := int64(20)
+
+		client.AddPagination(params, &PaginationParams{PageSize: &pageSize})
+
+		assert.Equal(t, "20", params.Get("page_size"))
+	})
+
+	t.Run("no default configured", func(t *testing.T) {
+		params := url.Values{}
+
+		NewClient("test-token").AddPagination(params, &PaginationParams{})
+
+		assert.Empty(t, params.Get("page_size"))
+	})
+}
+
+func TestBaseListRequest_Apply(t *testing.T) {
+	client := NewClient("test-token")
+
+	t.Run("all options", func(t *testing.T) {
+		params := url.Values{}
+		page := int64(2)
+		pageSize := int64(50)
+		orderField := "create_time"
+		orderType := OrderTypeDesc
+		req := &BaseListRequest{
+			Page:       &page,
+			PageSize:   &pageSize,
+			Fields:     []string{"ad_id", "ad_name"},
+			OrderField: &orderField,
+			OrderType:  &orderType,
+		}
+
+		require.NoError(t, req.Apply(client, params))
+
+		assert.Equal(t, "2", params.Get("page"))
+		assert.Equal(t, "50", params.Get("page_size"))
+		assert.Equal(t, `["ad_id","ad_name"]`, params.Get("fields"))
+		assert.Equal(t, "create_time", params.Get("order_field"))
+		assert.Equal(t, "DESC", params.Get("order_type"))
+	})
+
+	t.Run("empty request adds nothing", func(t *testing.T) {
+		params := url.Values{}
+
+		require.NoError(t, (&BaseListRequest{}).Apply(client, params))
+
+		assert.Empty(t, params)
+	})
+
+	t.Run("default page size", func(t *testing.T) {
+		params := url.Values{}
+		var req *BaseListRequest
+
+		require.NoError(t, req.Apply(NewClientWithConfig("test-token", "", nil, WithDefaultPageSize(500)), params))
+
+		assert.Equal(t, "500", params.Get("page_size"))
+	})
+}
+
 func TestAddJSONParam(t *testing.T) {
 	t.Run("with valid struct", func(t *testing.T) {
 		params := url.Values{}
@@ -121,6 +207,17 @@ func TestAddJSONParam(t *testing.T) {
 		assert.Empty(t, params.Get("test_key"))
 	})
 
+	t.Run("with value marshaling to null", func(t *testing.T) {
+		params := url.Values{}
+		var value *struct{ Name string }
+
+		err := AddJSONParam(params, "test_key", value)
+
+		require.NoError(t, err)
+		_, ok := params["test_key"]
+		assert.False(t, ok)
+	})
+
 	t.Run("with invalid value (channel)", func(t *testing.T) {
 		params := url.Values{}
 		value := make(chan int) // channels cannot be marshaled to JSON
@@ -176,6 +273,26 @@ func TestAddStringSlice(t *testing.T) {
 	})
 }
 
+func TestAddRepeatedParam(t *testing.T) {
+	t.Run("with multiple values", func(t *testing.T) {
+		params := url.Values{}
+
+		AddRepeatedParam(params, "rule_ids", []string{"1", "2"})
+
+		assert.Equal(t, []string{"1", "2"}, params["rule_ids"])
+		assert.Equal(t, "rule_ids=1&rule_ids=2", params.Encode())
+	})
+
+	t.Run("with empty slice", func(t *testing.T) {
+		params := url.Values{}
+
+		AddRepeatedParam(params, "rule_ids", nil)
+
+		_, ok := params["rule_ids"]
+		assert.False(t, ok)
+	})
+}
+
 func TestDoGet(t *testing.T) {
 	type TestResponse struct {
 		ID   string `json:"id"`
@@ -262,6 +379,60 @@ func TestDoGet(t *testing.T) {
 	})
 }
 
+func TestDoGet_DataShapes(t *testing.T) {
+	serve := func(t *testing.T, body string) *Client {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+		return NewClientWithConfig("test-token", server.URL, nil)
+	}
+
+	t.Run("object data", func(t *testing.T) {
+		client := serve(t, `{"code": 0, "message": "OK", "data": {"status": "SUCCESS", "task_id": "t1"}}`)
+
+		var result struct {
+			Status string `json:"status"`
+			TaskID string `json:"task_id"`
+		}
+		require.NoError(t, DoGet(context.Background(), client, "/test/path", nil, &result))
+		assert.Equal(t, "SUCCESS", result.Status)
+		assert.Equal(t, "t1", result.TaskID)
+	})
+
+	t.Run("list data", func(t *testing.T) {
+		client := serve(t, `{"code": 0, "message": "OK", "data": {"list": [{"id": "1"}, {"id": "2"}], "page_info": {"page": 1, "total_number": 2}}}`)
+
+		var result struct {
+			List     []struct{ ID string } `json:"list"`
+			PageInfo PageInfo              `json:"page_info"`
+		}
+		require.NoError(t, DoGet(context.Background(), client, "/test/path", nil, &result))
+		assert.Len(t, result.List, 2)
+		assert.Equal(t, int64(2), result.PageInfo.TotalNumber)
+	})
+
+	t.Run("null data", func(t *testing.T) {
+		client := serve(t, `{"code": 0, "message": "OK", "data": null}`)
+
+		var result struct {
+			Status string `json:"status"`
+		}
+		require.NoError(t, DoGet(context.Background(), client, "/test/path", nil, &result))
+		assert.Empty(t, result.Status)
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		client := serve(t, `{"code": 0, "message": "OK"}`)
+
+		var result struct {
+			List []string `json:"list"`
+		}
+		require.NoError(t, DoGet(context.Background(), client, "/test/path", nil, &result))
+		assert.Nil(t, result.List)
+	})
+}
+
 func TestDoPost(t *testing.T) {
 	type TestRequest struct {
 		Name  string `json:"name"`
@@ -356,3 +527,91 @@ func TestDoPost(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to unmarshal response")
 	})
 }
+
+func TestDoPostReader(t *testing.T) {
+	type TestResponse struct {
+		ID string `json:"id"`
+	}
+
+	payload := `{"advertiser_id":"123","creatives":[` + strings.Repeat(`{"ad_text":"streamed"},`, 999) + `{"ad_text":"streamed"}]}`
+
+	newServer := func(t *testing.T, wantLength int64) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/test/post", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "test-token", r.Header.Get("Access-Token"))
+			assert.Equal(t, wantLength, r.ContentLength)
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, payload, string(body))
+
+			response := Response{
+				Code: ptrInt64(0),
+				Data: json.RawMessage(`{"id":"created-123"}`),
+			}
+			json.NewEncoder(w).Encode(response)
+		}))
+	}
+
+	t.Run("known content length", func(t *testing.T) {
+		server := newServer(t, int64(len(payload)))
+		defer server.Close()
+
+		client := NewClientWithConfig("test-token", server.URL, nil)
+
+		// Hide the concrete reader type so the length comes from the argument
+		body := io.MultiReader(strings.NewReader(payload))
+
+		var result TestResponse
+		err := DoPostReader(context.Background(), client, "/test/post", body, int64(len(payload)), &result)
+
+		require.NoError(t, err)
+		assert.Equal(t, "created-123", result.ID)
+	})
+
+	t.Run("unknown content length streams chunked", func(t *testing.T) {
+		server := newServer(t, -1)
+		defer server.Close()
+
+		client := NewClientWithConfig("test-token", server.URL, nil)
+
+		pr, pw := io.Pipe()
+		go func() {
+			for i := 0; i < len(payload); i += 512 {
+				end := i + 512
+				if end > len(payload) {
+					end = len(payload)
+				}
+				pw.Write([]byte(payload[i:end]))
+			}
+			pw.Close()
+		}()
+
+		var result TestResponse
+		err := DoPostReader(context.Background(), client, "/test/post", pr, -1, &result)
+
+		require.NoError(t, err)
+		assert.Equal(t, "created-123", result.ID)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			response := Response{
+				Code:    ptrInt64(40001),
+				Message: ptrString("Invalid parameter"),
+			}
+			json.NewEncoder(w).Encode(response)
+		}))
+		defer server.Close()
+
+		client := NewClientWithConfig("test-token", server.URL, nil)
+
+		var result TestResponse
+		err := DoPostReader(context.Background(), client, "/test/post", strings.NewReader(`{}`), 2, &result)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid parameter")
+	})
+}
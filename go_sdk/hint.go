@@ -0,0 +1,23 @@
+package tiktok
+
+// genericHint is returned for error codes without a specific hint
+const genericHint = "check the error message and request ID, then see the API return code reference"
+
+// errorHints maps API error codes to remediation guidance
+var errorHints = map[int64]string{
+	40001:           "no permission for this operation; check the app's scopes and that the advertiser authorized the app",
+	40002:           "invalid request parameter; check the request fields against the API reference",
+	40100:           "access token expired or invalid; refresh it",
+	40104:           "access token is missing; pass it when creating the client",
+	40105:           "access token expired or revoked; refresh it or re-authorize the advertiser",
+	CodeRateLimited: "rate limited; back off and retry (see WithRateLimitRetry)",
+	50002:           "internal API error; retry later and contact support with the request ID if it persists",
+}
+
+// Hint returns actionable guidance for the error code
+func (e *ErrorResponse) Hint() string {
+	if hint, ok := errorHints[e.Code]; ok {
+		return hint
+	}
+	return genericHint
+}
@@ -0,0 +1,30 @@
+package tiktok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorResponse_Hint(t *testing.T) {
+	tests := []struct {
+		code     int64
+		contains string
+	}{
+		{40100, "refresh"},
+		{40001, "permission"},
+		{40002, "parameter"},
+		{CodeRateLimited, "retry"},
+	}
+
+	for _, tt := range tests {
+		hint := (&ErrorResponse{Code: tt.code}).Hint()
+		assert.NotEmpty(t, hint)
+		assert.NotEqual(t, genericHint, hint)
+		assert.Contains(t, hint, tt.contains)
+	}
+}
+
+func TestErrorResponse_HintUnknownCode(t *testing.T) {
+	assert.Equal(t, genericHint, (&ErrorResponse{Code: 99999}).Hint())
+}
@@ -0,0 +1,79 @@
+package tiktok
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// inflightCall is a GET request shared by concurrent callers; done is closed
+// once resp and err are set
+type inflightCall struct {
+	done chan struct{}
+	resp *Response
+	err  error
+}
+
+// inflightGroup coalesces concurrent identical requests into a single call
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// WithRequestCoalescing makes concurrent identical GET requests (same URL and query)
+// share a single HTTP call: callers that arrive while one is in flight wait for it and
+// receive its result. Only in-flight requests are shared; nothing is cached afterwards.
+// The shared call keeps the values of the context of the caller that started it but not
+// its cancellation or deadline, so that caller giving up does not fail the others; each
+// caller still stops waiting when its own context is done.
+func WithRequestCoalescing() ClientOption {
+	return func(c *Client) {
+		c.inflight = &inflightGroup{calls: make(map[string]*inflightCall)}
+	}
+}
+
+// do runs fn for key, or joins the call already running for key, and waits for the
+// result until ctx is done
+func (g *inflightGroup) do(ctx context.Context, key string, fn func(context.Context) (*Response, error)) (*Response, error) {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		call = &inflightCall{done: make(chan struct{})}
+		g.calls[key] = call
+		go g.run(context.WithoutCancel(ctx), key, call, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-call.done:
+		return call.result()
+	}
+}
+
+// run makes the shared call. The key is removed and done closed even if fn panics;
+// the panic is then reported to the callers as an error.
+func (g *inflightGroup) run(ctx context.Context, key string, call *inflightCall, fn func(context.Context) (*Response, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.resp, call.err = nil, fmt.Errorf("request panicked: %v", r)
+		}
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.resp, call.err = fn(ctx)
+}
+
+// result returns the call's outcome with a copy of the response, so that
+// callers do not share the envelope
+func (c *inflightCall) result() (*Response, error) {
+	if c.resp == nil {
+		return nil, c.err
+	}
+	resp := *c.resp
+	return &resp, c.err
+}
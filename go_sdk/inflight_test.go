@@ -0,0 +1,114 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestCoalescing_PostNotShared(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		json.NewEncoder(w).Encode(Response{Code: ptrInt64(0)})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRequestCoalescing())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Post(context.Background(), "/test/path", nil, map[string]string{"a": "b"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestInflightGroup_WaiterContextCancelled(t *testing.T) {
+	g := &inflightGroup{calls: make(map[string]*inflightCall)}
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	go func() {
+		_, _ = g.do(context.Background(), "GET /x", func(context.Context) (*Response, error) {
+			close(started)
+			<-release
+			return &Response{}, nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := g.do(ctx, "GET /x", func(context.Context) (*Response, error) {
+		t.Fatal("waiter should not start a new call")
+		return nil, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	close(release)
+}
+
+func TestInflightGroup_LeaderCancelDoesNotFailWaiters(t *testing.T) {
+	g := &inflightGroup{calls: make(map[string]*inflightCall)}
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := g.do(leaderCtx, "GET /x", func(ctx context.Context) (*Response, error) {
+			close(started)
+			<-release
+			return &Response{Data: json.RawMessage(`"shared"`)}, ctx.Err()
+		})
+		leaderErr <- err
+	}()
+	<-started
+
+	cancelLeader()
+	require.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	// The shared call is still blocked, so the next caller joins it
+	time.AfterFunc(10*time.Millisecond, func() { close(release) })
+	resp, err := g.do(context.Background(), "GET /x", func(context.Context) (*Response, error) {
+		t.Error("waiter should not start a new call")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, `"shared"`, string(resp.Data))
+}
+
+func TestInflightGroup_PanicCleansUp(t *testing.T) {
+	g := &inflightGroup{calls: make(map[string]*inflightCall)}
+
+	_, err := g.do(context.Background(), "GET /x", func(context.Context) (*Response, error) {
+		panic("boom")
+	})
+	require.ErrorContains(t, err, "boom")
+
+	g.mu.Lock()
+	assert.Empty(t, g.calls)
+	g.mu.Unlock()
+
+	resp, err := g.do(context.Background(), "GET /x", func(context.Context) (*Response, error) {
+		return &Response{}, nil
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
@@ -0,0 +1,107 @@
+package tiktok
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Entity types recorded in a Manifest
+const (
+	EntityTypeCampaign = "campaign"
+	EntityTypeAdGroup  = "adgroup"
+	EntityTypeAd       = "ad"
+)
+
+// ManifestEntry records one entity created through the client
+type ManifestEntry struct {
+	Type         string    `json:"type"`
+	ID           string    `json:"id"`
+	AdvertiserID string    `json:"advertiser_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Manifest is an append-only record of the entities created through a client,
+// for example to tear down everything a launch script or integration test created.
+// It is safe for concurrent use.
+type Manifest struct {
+	mu      sync.Mutex
+	entries []ManifestEntry
+	now     func() time.Time
+}
+
+// NewManifest creates an empty Manifest
+func NewManifest() *Manifest {
+	return &Manifest{now: time.Now}
+}
+
+// WithManifest records every successful campaign, ad group and ad create in m
+func WithManifest(m *Manifest) ClientOption {
+	return func(c *Client) {
+		c.manifest = m
+	}
+}
+
+// Manifest returns the manifest attached with WithManifest, or nil if there is none.
+// Recording into a nil Manifest is a no-op, so callers do not need to check.
+func (c *Client) Manifest() *Manifest {
+	return c.manifest
+}
+
+// Record appends one entry per ID
+func (m *Manifest) Record(entityType, advertiserID string, ids ...string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		m.entries = append(m.entries, ManifestEntry{
+			Type:         entityType,
+			ID:           id,
+			AdvertiserID: advertiserID,
+			CreatedAt:    now,
+		})
+	}
+}
+
+// Entries returns a copy of the recorded entries in creation order
+func (m *Manifest) Entries() []ManifestEntry {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := make([]ManifestEntry, len(m.entries))
+	copy(entries, m.entries)
+	return entries
+}
+
+// MarshalJSON encodes the manifest as a JSON array of entries
+func (m *Manifest) MarshalJSON() ([]byte, error) {
+	entries := m.Entries()
+	if entries == nil {
+		entries = []ManifestEntry{}
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON loads entries previously serialized with MarshalJSON,
+// so a manifest can be saved to disk and torn down later
+func (m *Manifest) UnmarshalJSON(data []byte) error {
+	var entries []ManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.now == nil {
+		m.now = time.Now
+	}
+	m.entries = entries
+	return nil
+}
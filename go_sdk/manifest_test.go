@@ -0,0 +1,67 @@
+package tiktok
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest_Record(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	m := NewManifest()
+	m.now = func() time.Time { return now }
+
+	m.Record(EntityTypeCampaign, "adv-1", "c-1")
+	m.Record(EntityTypeAdGroup, "adv-1", "ag-1", "", "ag-2")
+	m.Record(EntityTypeAd, "adv-1", "ad-1")
+
+	assert.Equal(t, []ManifestEntry{
+		{Type: EntityTypeCampaign, ID: "c-1", AdvertiserID: "adv-1", CreatedAt: now},
+		{Type: EntityTypeAdGroup, ID: "ag-1", AdvertiserID: "adv-1", CreatedAt: now},
+		{Type: EntityTypeAdGroup, ID: "ag-2", AdvertiserID: "adv-1", CreatedAt: now},
+		{Type: EntityTypeAd, ID: "ad-1", AdvertiserID: "adv-1", CreatedAt: now},
+	}, m.Entries())
+}
+
+func TestManifest_Entries_ReturnsCopy(t *testing.T) {
+	m := NewManifest()
+	m.Record(EntityTypeCampaign, "adv-1", "c-1")
+
+	entries := m.Entries()
+	entries[0].ID = "changed"
+
+	assert.Equal(t, "c-1", m.Entries()[0].ID)
+}
+
+func TestManifest_JSONRoundTrip(t *testing.T) {
+	m := NewManifest()
+	m.now = func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) }
+	m.Record(EntityTypeCampaign, "adv-1", "c-1")
+	m.Record(EntityTypeAd, "adv-1", "ad-1")
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "campaign", "id": "c-1", "advertiser_id": "adv-1", "created_at": "2024-06-01T12:00:00Z"},
+		{"type": "ad", "id": "ad-1", "advertiser_id": "adv-1", "created_at": "2024-06-01T12:00:00Z"}
+	]`, string(data))
+
+	loaded := NewManifest()
+	require.NoError(t, json.Unmarshal(data, loaded))
+	assert.Equal(t, m.Entries(), loaded.Entries())
+}
+
+func TestManifest_Nil(t *testing.T) {
+	client := NewClientWithConfig("test-token", "http://example.com", nil)
+
+	assert.Nil(t, client.Manifest())
+	assert.NotPanics(t, func() { client.Manifest().Record(EntityTypeAd, "adv-1", "ad-1") })
+	assert.Nil(t, client.Manifest().Entries())
+
+	data, err := json.Marshal(NewManifest())
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+}
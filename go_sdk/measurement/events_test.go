@@ -0,0 +1,180 @@
+package measurement
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetPixelEventConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/open_api/v1.3/pixel/list/", r.URL.Path)
+		assert.Equal(t, "123456789", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, "pixel-001", r.URL.Query().Get("pixel_id"))
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{
+				"pixels": [{
+					"pixel_id": "pixel-001",
+					"events": [
+						{"event_id": "e1", "event_code": "PageView", "event_name": "Page View", "event_type": "PAGE_VIEW", "statistic_type": "EVERY_TIME"},
+						{
+							"event_id": "e2",
+							"event_code": "CustomThankYou",
+							"event_name": "Thank You Page",
+							"event_type": "ON_WEB_ORDER",
+							"statistic_type": "ONCE",
+							"rules": [{"variable": "PAGE_URL", "operator": "OPERATORTYPE_CONTAINS", "value": "/thank-you", "trigger": "TRIGGERTYPE_PAGEVIEW"}]
+						}
+					]
+				}],
+				"page_info": {"page": 1, "page_size": 10, "total_number": 1, "total_page": 1}
+			}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	config, err := api.GetPixelEventConfig(context.Background(), "123456789", "pixel-001")
+	require.NoError(t, err)
+	require.Len(t, config.Events, 2)
+
+	standard := config.StandardEvents()
+	require.Len(t, standard, 1)
+	assert.Equal(t, "PAGE_VIEW", standard[0].EventType)
+
+	custom := config.CustomEvents()
+	require.Len(t, custom, 1)
+	assert.Equal(t, "Thank You Page", custom[0].EventName)
+	require.Len(t, custom[0].Rules, 1)
+	assert.Equal(t, "PAGE_URL", custom[0].Rules[0].Variable)
+	assert.Equal(t, "OPERATORTYPE_CONTAINS", custom[0].Rules[0].Operator)
+	assert.Equal(t, "/thank-you", custom[0].Rules[0].Value)
+}
+
+func TestGetPixelEventConfig_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"pixels":[],"page_info":{}}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetPixelEventConfig(context.Background(), "123456789", "pixel-404")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pixel-404")
+}
+
+func TestCreatePixelEventRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/pixel/event/create/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"advertiser_id": "123456789",
+			"pixel_id":      "pixel-001",
+			"pixel_events": []interface{}{
+				map[string]interface{}{
+					"event_name":     "Purchase Complete",
+					"event_type":     "ON_WEB_ORDER",
+					"statistic_type": "EVERY_TIME",
+					"currency":       "USD",
+					"currency_value": "25",
+					"rules": []interface{}{
+						map[string]interface{}{
+							"variable": "PAGE_URL",
+							"operator": "OPERATORTYPE_CONTAINS",
+							"value":    "/order/complete",
+							"trigger":  "TRIGGERTYPE_PAGEVIEW",
+						},
+					},
+				},
+			},
+		}, body)
+
+		response := tiktok.Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{}`),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.CreatePixelEventRule(context.Background(), &PixelEventRuleRequest{
+		AdvertiserID: "123456789",
+		PixelID:      "pixel-001",
+		PixelEvents: []PixelEvent{{
+			EventName:     "Purchase Complete",
+			EventType:     "ON_WEB_ORDER",
+			StatisticType: StatisticTypeEveryTime,
+			Currency:      "USD",
+			CurrencyValue: "25",
+			Rules: []PixelEventRule{{
+				Variable: RuleVariablePageURL,
+				Operator: RuleOperatorContains,
+				Value:    "/order/complete",
+				Trigger:  RuleTriggerPageView,
+			}},
+		}},
+	})
+	require.NoError(t, err)
+}
+
+func TestPixelEventRuleRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     PixelEventRuleRequest
+		wantErr string
+	}{
+		{
+			name:    "missing pixel",
+			req:     PixelEventRuleRequest{PixelEvents: []PixelEvent{{EventType: "ON_WEB_ORDER"}}},
+			wantErr: "pixel ID is required",
+		},
+		{
+			name:    "no events",
+			req:     PixelEventRuleRequest{PixelID: "pixel-001"},
+			wantErr: "at least one pixel event is required",
+		},
+		{
+			name:    "missing event type",
+			req:     PixelEventRuleRequest{PixelID: "pixel-001", PixelEvents: []PixelEvent{{EventName: "Purchase"}}},
+			wantErr: "pixel event 0: event type is required",
+		},
+		{
+			name: "incomplete rule",
+			req: PixelEventRuleRequest{PixelID: "pixel-001", PixelEvents: []PixelEvent{{
+				EventType: "ON_WEB_ORDER",
+				Rules:     []PixelEventRule{{Variable: RuleVariablePageURL, Value: "/thanks"}},
+			}}},
+			wantErr: "pixel event 0: rule 0 requires variable, operator and trigger",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -52,8 +52,12 @@ type PixelListRequest struct {
 // ListPixels obtains a list of Pixel information
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740858697598978
 func (a *API) ListPixels(ctx context.Context, req *PixelListRequest) (*PixelListResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
 	if req.PixelID != nil {
 		params.Set("pixel_id", *req.PixelID)
@@ -77,7 +81,7 @@ func (a *API) ListPixels(ctx context.Context, req *PixelListRequest) (*PixelList
 	}
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -117,10 +121,14 @@ type OfflineGetRequest struct {
 // GetOfflineEventSets gets Offline Event sets
 // Reference: https://business-api.tiktok.com/portal/docs?id=1765596808589313
 func (a *API) GetOfflineEventSets(ctx context.Context, req *OfflineGetRequest) (*OfflineGetResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
 
-	if req.AdvertiserID != "" {
-		params.Set("advertiser_id", req.AdvertiserID)
+	if advertiserID := tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID); advertiserID != "" {
+		params.Set("advertiser_id", advertiserID)
 	}
 
 	// Add event_set_ids using helper
@@ -0,0 +1,86 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MaxPageSize is the largest page size accepted by most list endpoints
+const MaxPageSize int64 = 100
+
+// ErrTooManyItems is returned by PaginateLimit when the results exceed the item limit
+var ErrTooManyItems = errors.New("too many items")
+
+// PageFetcher fetches a single page of results
+type PageFetcher[T any] func(ctx context.Context, page, pageSize int64) ([]T, PageInfo, error)
+
+// OnPageFunc reports pagination progress after each page: the page just fetched,
+// the total page count reported by the API (0 if unknown) and the number of items fetched so far
+type OnPageFunc func(page, totalPage int64, fetched int)
+
+type onPageKey struct{}
+
+// WithPageProgress returns a copy of ctx that makes Paginate, and the GetAll methods
+// built on it, call onPage after each page
+func WithPageProgress(ctx context.Context, onPage OnPageFunc) context.Context {
+	return context.WithValue(ctx, onPageKey{}, onPage)
+}
+
+// Paginate calls fetch for successive pages, starting at page 1, and returns all items.
+// It stops when page reaches PageInfo.TotalPage or a page comes back empty. When
+// TotalPage is missing or zero, it also stops on a page shorter than the page
+// size, so a malformed TotalPage cannot loop forever or cut results short.
+// Progress is reported to the callback set with WithPageProgress, if any.
+func Paginate[T any](ctx context.Context, pageSize int64, fetch PageFetcher[T]) ([]T, error) {
+	return PaginateLimit(ctx, pageSize, 0, fetch)
+}
+
+// PaginateLimit works like Paginate but fails with ErrTooManyItems as soon as the
+// results are known to exceed maxItems, either from PageInfo.TotalNumber or from
+// the items fetched so far. A maxItems of 0 or less means no limit.
+func PaginateLimit[T any](ctx context.Context, pageSize int64, maxItems int, fetch PageFetcher[T]) ([]T, error) {
+	onPage, _ := ctx.Value(onPageKey{}).(OnPageFunc)
+
+	var all []T
+	for page := int64(1); ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		items, info, err := fetch(ctx, page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if onPage != nil {
+			onPage(page, info.TotalPage, len(all))
+		}
+
+		if maxItems > 0 && (len(all) > maxItems || info.TotalNumber > int64(maxItems)) {
+			return nil, fmt.Errorf("%w: more than %d items", ErrTooManyItems, maxItems)
+		}
+
+		if isLastPage(page, pageSize, len(items), info) {
+			return all, nil
+		}
+	}
+}
+
+// isLastPage reports whether pagination is exhausted after the given page
+func isLastPage(page, pageSize int64, count int, info PageInfo) bool {
+	if count == 0 {
+		return true
+	}
+	if info.TotalPage > 0 {
+		return page >= info.TotalPage
+	}
+
+	// The server may cap the page size below what was requested
+	effective := pageSize
+	if info.PageSize > 0 && info.PageSize < effective {
+		effective = info.PageSize
+	}
+	return int64(count) < effective
+}
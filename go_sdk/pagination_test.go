@@ -0,0 +1,165 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagesFetcher serves the given pages with the given page info and counts calls
+func pagesFetcher(calls *int, pages [][]int, info func(page int64) PageInfo) PageFetcher[int] {
+	return func(ctx context.Context, page, pageSize int64) ([]int, PageInfo, error) {
+		*calls++
+		if page > int64(len(pages)) {
+			return nil, info(page), nil
+		}
+		return pages[page-1], info(page), nil
+	}
+}
+
+func TestPaginate_TotalPage(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1}, {2}, {3}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, PageSize: 100, TotalPage: 3}
+	})
+
+	items, err := Paginate(context.Background(), 100, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPaginate_ZeroTotalPageWithData(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3, 4}, {5}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, TotalPage: 0}
+	})
+
+	items, err := Paginate(context.Background(), 2, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPaginate_ZeroTotalPageFullLastPage(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3, 4}}, func(page int64) PageInfo {
+		return PageInfo{}
+	})
+
+	items, err := Paginate(context.Background(), 2, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, items)
+	// The third call returns an empty page and ends the loop
+	assert.Equal(t, 3, calls)
+}
+
+func TestPaginate_ServerCapsPageSize(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, PageSize: 2}
+	})
+
+	items, err := Paginate(context.Background(), 100, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPaginate_EmptyFirstPage(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, nil, func(page int64) PageInfo {
+		return PageInfo{TotalPage: 5}
+	})
+
+	items, err := Paginate(context.Background(), 100, fetch)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Equal(t, 1, calls)
+}
+
+func TestPaginate_Error(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, page, pageSize int64) ([]int, PageInfo, error) {
+		if page == 2 {
+			return nil, PageInfo{}, boom
+		}
+		return []int{1}, PageInfo{TotalPage: 3}, nil
+	}
+
+	_, err := Paginate(context.Background(), 100, fetch)
+	assert.ErrorIs(t, err, boom)
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fetch := func(ctx context.Context, page, pageSize int64) ([]int, PageInfo, error) {
+		calls++
+		cancel()
+		return []int{1}, PageInfo{TotalPage: 10}, nil
+	}
+
+	_, err := Paginate(ctx, 100, fetch)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
+
+func TestPaginate_PageProgress(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2, 3}, {4}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, PageSize: 3, TotalPage: 2}
+	})
+
+	type progress struct {
+		page, totalPage int64
+		fetched         int
+	}
+	var got []progress
+	ctx := WithPageProgress(context.Background(), func(page, totalPage int64, fetched int) {
+		got = append(got, progress{page, totalPage, fetched})
+	})
+
+	items, err := Paginate(ctx, 3, fetch)
+	require.NoError(t, err)
+	assert.Len(t, items, 4)
+	assert.Equal(t, []progress{{1, 2, 3}, {2, 2, 4}}, got)
+}
+
+func TestPaginateLimit_TotalNumberExceedsLimit(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3, 4}, {5}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, PageSize: 2, TotalNumber: 5, TotalPage: 3}
+	})
+
+	items, err := PaginateLimit(context.Background(), 2, 4, fetch)
+	assert.ErrorIs(t, err, ErrTooManyItems)
+	assert.Nil(t, items)
+	// The total is known from the first page, so no more pages are fetched
+	assert.Equal(t, 1, calls)
+}
+
+func TestPaginateLimit_ItemsExceedLimit(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3, 4}, {5}}, func(page int64) PageInfo {
+		return PageInfo{}
+	})
+
+	_, err := PaginateLimit(context.Background(), 2, 3, fetch)
+	assert.ErrorIs(t, err, ErrTooManyItems)
+	assert.Equal(t, 2, calls)
+}
+
+func TestPaginateLimit_WithinLimit(t *testing.T) {
+	calls := 0
+	fetch := pagesFetcher(&calls, [][]int{{1, 2}, {3}}, func(page int64) PageInfo {
+		return PageInfo{Page: page, PageSize: 2, TotalNumber: 3, TotalPage: 2}
+	})
+
+	items, err := PaginateLimit(context.Background(), 2, 3, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+}
@@ -0,0 +1,125 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// AccountSettings is the currency and timezone of an advertiser account.
+// Report spend is in Currency and report dates are in Timezone.
+type AccountSettings struct {
+	Currency string `json:"currency"`
+	Timezone string `json:"timezone"`
+}
+
+// AnnotatedReport is an integrated report with the account settings of its advertisers
+type AnnotatedReport struct {
+	IntegratedGetResponse
+	// Accounts maps each advertiser ID in the report to its account settings
+	Accounts map[string]AccountSettings `json:"accounts"`
+}
+
+// AccountFor returns the account settings of the advertiser a row belongs to.
+// Rows are matched on their advertiser_id dimension; in a single-advertiser report
+// every row belongs to that advertiser.
+func (r *AnnotatedReport) AccountFor(row map[string]interface{}) AccountSettings {
+	if id := rowAdvertiserID(row); id != "" {
+		return r.Accounts[id]
+	}
+	if len(r.Accounts) == 1 {
+		for _, settings := range r.Accounts {
+			return settings
+		}
+	}
+	return AccountSettings{}
+}
+
+// AccountAnnotator runs integrated reports and annotates them with the currency and
+// timezone of each advertiser. Advertiser settings come from the client's advertiser
+// metadata cache (see tiktok.Client.AdvertiserMetas), so they are shared with other
+// helpers and refreshed after the client's TTL. It is safe for concurrent use.
+type AccountAnnotator struct {
+	client  *tiktok.Client
+	reports *API
+}
+
+// NewAccountAnnotator creates an AccountAnnotator
+func NewAccountAnnotator(client *tiktok.Client) *AccountAnnotator {
+	return &AccountAnnotator{
+		client:  client,
+		reports: NewAPI(client),
+	}
+}
+
+// GetIntegratedReport runs the report and annotates it with the account settings of its advertisers.
+// Requests with AdvertiserIDs run through GetIntegratedReportMultiAdv.
+func (a *AccountAnnotator) GetIntegratedReport(ctx context.Context, req *IntegratedGetRequest) (*AnnotatedReport, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	var resp *IntegratedGetResponse
+	if len(req.AdvertiserIDs) > 0 {
+		multi, err := a.reports.GetIntegratedReportMultiAdv(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp = &multi.IntegratedGetResponse
+	} else {
+		var err error
+		if resp, err = a.reports.GetIntegratedReport(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	accounts, err := a.accountSettings(ctx, reportAdvertiserIDs(ctx, req, resp.List))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnnotatedReport{IntegratedGetResponse: *resp, Accounts: accounts}, nil
+}
+
+// accountSettings returns the settings of advertiserIDs from the client's advertiser metadata cache
+func (a *AccountAnnotator) accountSettings(ctx context.Context, advertiserIDs []string) (map[string]AccountSettings, error) {
+	metas, err := a.client.AdvertiserMetas(ctx, advertiserIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account settings: %w", err)
+	}
+
+	accounts := make(map[string]AccountSettings, len(metas))
+	for id, meta := range metas {
+		accounts[id] = AccountSettings{Currency: meta.Currency, Timezone: meta.Timezone}
+	}
+	return accounts, nil
+}
+
+// reportAdvertiserIDs returns the advertisers a report covers: those in the request
+// (or context) followed by any other advertiser_id found in the rows
+func reportAdvertiserIDs(ctx context.Context, req *IntegratedGetRequest, rows []map[string]interface{}) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if req.AdvertiserID != nil {
+		add(*req.AdvertiserID)
+	} else if len(req.AdvertiserIDs) == 0 && req.BcID == nil {
+		if id, ok := tiktok.AdvertiserIDFromContext(ctx); ok {
+			add(id)
+		}
+	}
+	for _, id := range req.AdvertiserIDs {
+		add(id)
+	}
+	for _, row := range rows {
+		add(rowAdvertiserID(row))
+	}
+	return ids
+}
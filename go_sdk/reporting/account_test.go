@@ -0,0 +1,177 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+	"github.com/suthio/tiktok-business-api-sdk/go_sdk/account"
+)
+
+func TestAccountAnnotator_GetIntegratedReport(t *testing.T) {
+	infoCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/integrated/get/":
+			data = map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"dimensions": map[string]interface{}{"advertiser_id": "111", "stat_time_day": "2024-01-01 00:00:00"},
+						"metrics":    map[string]interface{}{"spend": "10.00"},
+					},
+					{
+						"dimensions": map[string]interface{}{"advertiser_id": "222", "stat_time_day": "2024-01-01 00:00:00"},
+						"metrics":    map[string]interface{}{"spend": "1500"},
+					},
+				},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 2, "total_page": 1},
+			}
+		case "/open_api/v1.3/advertiser/info/":
+			infoCalls++
+			assert.Equal(t, `["111","222"]`, r.URL.Query().Get("advertiser_ids"))
+			assert.Equal(t, `["advertiser_id","currency","timezone"]`, r.URL.Query().Get("fields"))
+			data = map[string]interface{}{
+				"list": []map[string]interface{}{
+					{"advertiser_id": "111", "currency": "USD", "timezone": "America/New_York"},
+					{"advertiser_id": "222", "currency": "JPY", "timezone": "Asia/Tokyo"},
+				},
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": data}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	annotator := NewAccountAnnotator(client)
+
+	serviceType := ServiceTypeAuction
+	dataLevel := DataLevelAdvertiser
+	req := &IntegratedGetRequest{
+		ReportType:    ReportTypeBasic,
+		AdvertiserIDs: []string{"111", "222"},
+		ServiceType:   &serviceType,
+		DataLevel:     &dataLevel,
+		Dimensions:    []string{"advertiser_id", "stat_time_day"},
+		Metrics:       []string{"spend"},
+	}
+
+	for i := 0; i < 2; i++ {
+		report, err := annotator.GetIntegratedReport(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, report.List, 2)
+
+		assert.Equal(t, AccountSettings{Currency: "USD", Timezone: "America/New_York"}, report.AccountFor(report.List[0]))
+		assert.Equal(t, AccountSettings{Currency: "JPY", Timezone: "Asia/Tokyo"}, report.AccountFor(report.List[1]))
+	}
+
+	assert.Equal(t, 1, infoCalls)
+}
+
+func TestAnnotatedReport_AccountFor_SingleAdvertiser(t *testing.T) {
+	report := &AnnotatedReport{
+		Accounts: map[string]AccountSettings{"111": {Currency: "EUR", Timezone: "Europe/Paris"}},
+	}
+
+	row := map[string]interface{}{"dimensions": map[string]interface{}{"stat_time_day": "2024-01-01 00:00:00"}}
+	assert.Equal(t, "EUR", report.AccountFor(row).Currency)
+}
+
+func TestAccountAnnotator_AccountSettings_Batches(t *testing.T) {
+	var batchSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ids []string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("advertiser_ids")), &ids))
+		batchSizes = append(batchSizes, len(ids))
+
+		list := make([]map[string]interface{}, len(ids))
+		for i, id := range ids {
+			list[i] = map[string]interface{}{"advertiser_id": id, "currency": "USD", "timezone": "UTC"}
+		}
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": map[string]interface{}{"list": list}}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	annotator := NewAccountAnnotator(tiktok.NewClientWithConfig("test_token", server.URL, nil))
+
+	ids := make([]string, account.MaxAdvertiserInfoIDs+50)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("adv-%d", i)
+	}
+
+	accounts, err := annotator.accountSettings(context.Background(), ids)
+	require.NoError(t, err)
+	assert.Len(t, accounts, len(ids))
+	assert.Equal(t, []int{account.MaxAdvertiserInfoIDs, 50}, batchSizes)
+}
+
+func TestAccountAnnotator_AccountSettings_KnownNotBlocked(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list := []map[string]interface{}{{"advertiser_id": "111", "currency": "USD", "timezone": "America/New_York"}}
+		if r.URL.Query().Get("advertiser_ids") == `["222"]` {
+			<-release
+			list = []map[string]interface{}{{"advertiser_id": "222", "currency": "JPY", "timezone": "Asia/Tokyo"}}
+		}
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": map[string]interface{}{"list": list}}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	annotator := NewAccountAnnotator(tiktok.NewClientWithConfig("test_token", server.URL, nil))
+	_, err := annotator.accountSettings(context.Background(), []string{"111"})
+	require.NoError(t, err)
+
+	go annotator.accountSettings(context.Background(), []string{"222"})
+
+	done := make(chan map[string]AccountSettings)
+	go func() {
+		accounts, _ := annotator.accountSettings(context.Background(), []string{"111"})
+		done <- accounts
+	}()
+
+	select {
+	case accounts := <-done:
+		assert.Equal(t, "USD", accounts["111"].Currency)
+	case <-time.After(time.Second):
+		t.Fatal("lookup of a known advertiser waited on another caller's request")
+	}
+}
+
+func TestAccountAnnotator_SharesClientAdvertiserMeta(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		response := map[string]interface{}{"code": 0, "message": "OK", "data": map[string]interface{}{
+			"list": []map[string]interface{}{{"advertiser_id": "111", "currency": "EUR", "timezone": "Europe/Paris"}},
+		}}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	_, err := client.AdvertiserMeta(context.Background(), "111")
+	require.NoError(t, err)
+
+	accounts, err := NewAccountAnnotator(client).accountSettings(context.Background(), []string{"111"})
+	require.NoError(t, err)
+	assert.Equal(t, AccountSettings{Currency: "EUR", Timezone: "Europe/Paris"}, accounts["111"])
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,109 @@
+package reporting
+
+import "encoding/json"
+
+// Report filter types
+const (
+	FilterTypeIn           = "IN"
+	FilterTypeMatch        = "MATCH"
+	FilterTypeGreaterEqual = "GREATER_EQUAL"
+	FilterTypeGreaterThan  = "GREATER_THAN"
+	FilterTypeLowerEqual   = "LOWER_EQUAL"
+	FilterTypeLowerThan    = "LOWER_THAN"
+	FilterTypeBetween      = "BETWEEN"
+)
+
+// Report status filter values, used with the campaign, ad group and ad status filters
+const (
+	ReportStatusAll         = "STATUS_ALL"
+	ReportStatusNotDelete   = "STATUS_NOT_DELETE"
+	ReportStatusNotDelivery = "STATUS_NOT_DELIVERY"
+	ReportStatusDeliveryOK  = "STATUS_DELIVERY_OK"
+	ReportStatusDisable     = "STATUS_DISABLE"
+	ReportStatusDelete      = "STATUS_DELETE"
+)
+
+// ReportFilter represents one entry of the filtering parameter.
+// FilterValue is a string; list values are JSON-encoded (e.g. `["123","456"]`).
+type ReportFilter struct {
+	FieldName   string `json:"field_name"`
+	FilterType  string `json:"filter_type"`
+	FilterValue string `json:"filter_value"`
+}
+
+// ReportFiltering is a typed filter that can be assigned to the Filtering field of
+// report requests. Empty fields are left out; an empty ReportFiltering marshals to null,
+// which the report methods leave out of the request.
+type ReportFiltering struct {
+	CampaignIDs   []string
+	AdgroupIDs    []string
+	AdIDs         []string
+	ObjectiveType []string
+
+	CampaignStatus []string
+	AdgroupStatus  []string
+	AdStatus       []string
+}
+
+// WithCampaignStatus filters the report to campaigns with one of statuses
+func (f *ReportFiltering) WithCampaignStatus(statuses ...string) *ReportFiltering {
+	f.CampaignStatus = statuses
+	return f
+}
+
+// WithAdgroupStatus filters the report to ad groups with one of statuses
+func (f *ReportFiltering) WithAdgroupStatus(statuses ...string) *ReportFiltering {
+	f.AdgroupStatus = statuses
+	return f
+}
+
+// WithAdStatus filters the report to ads with one of statuses
+func (f *ReportFiltering) WithAdStatus(statuses ...string) *ReportFiltering {
+	f.AdStatus = statuses
+	return f
+}
+
+// DeliveringOnly filters the report to ads that are currently delivering
+func (f *ReportFiltering) DeliveringOnly() *ReportFiltering {
+	return f.WithAdStatus(ReportStatusDeliveryOK)
+}
+
+// Filters converts the typed filter into the report filter entries sent to the API
+func (f ReportFiltering) Filters() ([]ReportFilter, error) {
+	var filters []ReportFilter
+	for _, field := range []struct {
+		name   string
+		values []string
+	}{
+		{"campaign_ids", f.CampaignIDs},
+		{"adgroup_ids", f.AdgroupIDs},
+		{"ad_ids", f.AdIDs},
+		{"objective_type", f.ObjectiveType},
+		{"campaign_status", f.CampaignStatus},
+		{"adgroup_status", f.AdgroupStatus},
+		{"ad_status", f.AdStatus},
+	} {
+		if len(field.values) == 0 {
+			continue
+		}
+		value, err := json.Marshal(field.values)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, ReportFilter{
+			FieldName:   field.name,
+			FilterType:  FilterTypeIn,
+			FilterValue: string(value),
+		})
+	}
+	return filters, nil
+}
+
+// MarshalJSON encodes the filter in the filtering format expected by the report endpoints
+func (f ReportFiltering) MarshalJSON() ([]byte, error) {
+	filters, err := f.Filters()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(filters)
+}
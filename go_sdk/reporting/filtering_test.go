@@ -0,0 +1,130 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestReportFiltering_MarshalJSON(t *testing.T) {
+	filtering := ReportFiltering{
+		CampaignIDs:   []string{"c1", "c2"},
+		AdIDs:         []string{"a1"},
+		ObjectiveType: []string{"TRAFFIC"},
+	}
+
+	data, err := json.Marshal(filtering)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{"field_name": "campaign_ids", "filter_type": "IN", "filter_value": "[\"c1\",\"c2\"]"},
+		{"field_name": "ad_ids", "filter_type": "IN", "filter_value": "[\"a1\"]"},
+		{"field_name": "objective_type", "filter_type": "IN", "filter_value": "[\"TRAFFIC\"]"}
+	]`, string(data))
+}
+
+func TestReportFiltering_Empty(t *testing.T) {
+	data, err := json.Marshal(ReportFiltering{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestReportFiltering_DeliveringOnly(t *testing.T) {
+	filtering := (&ReportFiltering{CampaignIDs: []string{"c1"}}).DeliveringOnly()
+
+	data, err := json.Marshal(filtering)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{"field_name": "campaign_ids", "filter_type": "IN", "filter_value": "[\"c1\"]"},
+		{"field_name": "ad_status", "filter_type": "IN", "filter_value": "[\"STATUS_DELIVERY_OK\"]"}
+	]`, string(data))
+}
+
+func TestReportFiltering_StatusBuilders(t *testing.T) {
+	filters, err := new(ReportFiltering).
+		WithCampaignStatus(ReportStatusNotDelete).
+		WithAdgroupStatus(ReportStatusDisable, ReportStatusDelete).
+		Filters()
+	require.NoError(t, err)
+
+	require.Len(t, filters, 2)
+	assert.Equal(t, ReportFilter{FieldName: "campaign_status", FilterType: FilterTypeIn, FilterValue: `["STATUS_NOT_DELETE"]`}, filters[0])
+	assert.Equal(t, ReportFilter{FieldName: "adgroup_status", FilterType: FilterTypeIn, FilterValue: `["STATUS_DISABLE","STATUS_DELETE"]`}, filters[1])
+}
+
+func TestGetIntegratedReport_TypedFiltering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var filters []map[string]string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("filtering")), &filters))
+		require.Len(t, filters, 1)
+		assert.Equal(t, map[string]string{
+			"field_name":   "adgroup_ids",
+			"filter_type":  "IN",
+			"filter_value": `["ag1"]`,
+		}, filters[0])
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list":      []map[string]interface{}{},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 0, "total_page": 0},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123456"
+	req := &IntegratedGetRequest{
+		ReportType:   ReportTypeBasic,
+		AdvertiserID: &advertiserID,
+		Filtering:    &ReportFiltering{AdgroupIDs: []string{"ag1"}},
+	}
+
+	_, err := api.GetIntegratedReport(context.Background(), req)
+	require.NoError(t, err)
+}
+
+func TestGetIntegratedReport_EmptyTypedFiltering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := r.URL.Query()["filtering"]
+		assert.False(t, ok, "an empty ReportFiltering should not send filtering")
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list":      []map[string]interface{}{},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 0, "total_page": 0},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123456"
+	req := &IntegratedGetRequest{
+		ReportType:   ReportTypeBasic,
+		AdvertiserID: &advertiserID,
+		Filtering:    &ReportFiltering{},
+	}
+
+	_, err := api.GetIntegratedReport(context.Background(), req)
+	require.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+)
+
+// Service types
+const (
+	ServiceTypeAuction     = "AUCTION"
+	ServiceTypeReservation = "RESERVATION"
+)
+
+// Report types
+const (
+	ReportTypeBasic = "BASIC"
+)
+
+// Auction data levels
+const (
+	DataLevelAdvertiser = "AUCTION_ADVERTISER"
+	DataLevelCampaign   = "AUCTION_CAMPAIGN"
+	DataLevelAdGroup    = "AUCTION_ADGROUP"
+	DataLevelAd         = "AUCTION_AD"
+)
+
+// Reservation data levels
+const (
+	DataLevelReservationAdvertiser = "RESERVATION_ADVERTISER"
+	DataLevelReservationCampaign   = "RESERVATION_CAMPAIGN"
+	DataLevelReservationAdGroup    = "RESERVATION_ADGROUP"
+	DataLevelReservationAd         = "RESERVATION_AD"
+)
+
+// reservationDataLevels lists the data levels accepted for RESERVATION reports
+var reservationDataLevels = map[string]bool{
+	DataLevelReservationAdvertiser: true,
+	DataLevelReservationCampaign:   true,
+	DataLevelReservationAdGroup:    true,
+	DataLevelReservationAd:         true,
+}
+
+// Validate checks the request for combinations the API would reject
+func (r *IntegratedGetRequest) Validate() error {
+	if r.ServiceType != nil && *r.ServiceType == ServiceTypeReservation &&
+		r.DataLevel != nil && !reservationDataLevels[*r.DataLevel] {
+		return fmt.Errorf("data level %s is not supported for %s reports", *r.DataLevel, ServiceTypeReservation)
+	}
+	return nil
+}
+
+// DateRange represents an inclusive report date range in YYYY-MM-DD format
+type DateRange struct {
+	StartDate string
+	EndDate   string
+}
+
+// CampaignReport runs a daily basic report broken down by campaign
+func (a *API) CampaignReport(ctx context.Context, advertiserID string, dateRange DateRange, metrics []string) (*IntegratedGetResponse, error) {
+	return a.levelReport(ctx, advertiserID, dateRange, metrics, DataLevelCampaign, "campaign_id")
+}
+
+// AdGroupReport runs a daily basic report broken down by ad group
+func (a *API) AdGroupReport(ctx context.Context, advertiserID string, dateRange DateRange, metrics []string) (*IntegratedGetResponse, error) {
+	return a.levelReport(ctx, advertiserID, dateRange, metrics, DataLevelAdGroup, "adgroup_id")
+}
+
+// AdReport runs a daily basic report broken down by ad
+func (a *API) AdReport(ctx context.Context, advertiserID string, dateRange DateRange, metrics []string) (*IntegratedGetResponse, error) {
+	return a.levelReport(ctx, advertiserID, dateRange, metrics, DataLevelAd, "ad_id")
+}
+
+// levelReport builds a basic auction report keyed by idDimension and stat_time_day
+func (a *API) levelReport(ctx context.Context, advertiserID string, dateRange DateRange, metrics []string, dataLevel, idDimension string) (*IntegratedGetResponse, error) {
+	serviceType := ServiceTypeAuction
+
+	req := &IntegratedGetRequest{
+		ReportType:  ReportTypeBasic,
+		ServiceType: &serviceType,
+		DataLevel:   &dataLevel,
+		Dimensions:  []string{idDimension, "stat_time_day"},
+		Metrics:     metrics,
+		StartDate:   &dateRange.StartDate,
+		EndDate:     &dateRange.EndDate,
+	}
+
+	// Leave AdvertiserID unset when empty so a context-scoped advertiser ID applies
+	if advertiserID != "" {
+		req.AdvertiserID = &advertiserID
+	}
+
+	return a.GetIntegratedReport(ctx, req)
+}
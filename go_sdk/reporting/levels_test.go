@@ -0,0 +1,142 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestLevelReports(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(api *API) (*IntegratedGetResponse, error)
+		dataLevel  string
+		dimensions string
+	}{
+		{
+			name: "campaign",
+			call: func(api *API) (*IntegratedGetResponse, error) {
+				return api.CampaignReport(context.Background(), "123456", DateRange{StartDate: "2024-01-01", EndDate: "2024-01-31"}, []string{"spend"})
+			},
+			dataLevel:  "AUCTION_CAMPAIGN",
+			dimensions: `["campaign_id","stat_time_day"]`,
+		},
+		{
+			name: "ad group",
+			call: func(api *API) (*IntegratedGetResponse, error) {
+				return api.AdGroupReport(context.Background(), "123456", DateRange{StartDate: "2024-01-01", EndDate: "2024-01-31"}, []string{"spend"})
+			},
+			dataLevel:  "AUCTION_ADGROUP",
+			dimensions: `["adgroup_id","stat_time_day"]`,
+		},
+		{
+			name: "ad",
+			call: func(api *API) (*IntegratedGetResponse, error) {
+				return api.AdReport(context.Background(), "123456", DateRange{StartDate: "2024-01-01", EndDate: "2024-01-31"}, []string{"spend"})
+			},
+			dataLevel:  "AUCTION_AD",
+			dimensions: `["ad_id","stat_time_day"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/open_api/v1.3/report/integrated/get/", r.URL.Path)
+
+				query := r.URL.Query()
+				assert.Equal(t, "BASIC", query.Get("report_type"))
+				assert.Equal(t, "123456", query.Get("advertiser_id"))
+				assert.Equal(t, "AUCTION", query.Get("service_type"))
+				assert.Equal(t, tt.dataLevel, query.Get("data_level"))
+				assert.Equal(t, tt.dimensions, query.Get("dimensions"))
+				assert.Equal(t, `["spend"]`, query.Get("metrics"))
+				assert.Equal(t, "2024-01-01", query.Get("start_date"))
+				assert.Equal(t, "2024-01-31", query.Get("end_date"))
+
+				response := map[string]interface{}{
+					"code":    0,
+					"message": "OK",
+					"data": map[string]interface{}{
+						"list":      []map[string]interface{}{},
+						"page_info": map[string]interface{}{},
+					},
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(response)
+			}))
+			defer server.Close()
+
+			client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+			api := NewAPI(client)
+
+			_, err := tt.call(api)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetIntegratedReport_Reservation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "RESERVATION", r.URL.Query().Get("service_type"))
+		assert.Equal(t, "RESERVATION_CAMPAIGN", r.URL.Query().Get("data_level"))
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list":      []map[string]interface{}{},
+				"page_info": map[string]interface{}{},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123456"
+	serviceType := ServiceTypeReservation
+	dataLevel := DataLevelReservationCampaign
+	_, err := api.GetIntegratedReport(context.Background(), &IntegratedGetRequest{
+		ReportType:   ReportTypeBasic,
+		AdvertiserID: &advertiserID,
+		ServiceType:  &serviceType,
+		DataLevel:    &dataLevel,
+		Dimensions:   []string{"campaign_id"},
+		Metrics:      []string{"spend"},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetIntegratedReport_ReservationRejectsAuctionDataLevel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123456"
+	serviceType := ServiceTypeReservation
+	dataLevel := DataLevelCampaign
+	_, err := api.GetIntegratedReport(context.Background(), &IntegratedGetRequest{
+		ReportType:   ReportTypeBasic,
+		AdvertiserID: &advertiserID,
+		ServiceType:  &serviceType,
+		DataLevel:    &dataLevel,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AUCTION_CAMPAIGN")
+}
@@ -0,0 +1,26 @@
+package reporting
+
+// Video play metrics, available at every auction data level
+const (
+	MetricVideoWatched2s          = "video_watched_2s"
+	MetricVideoWatched6s          = "video_watched_6s"
+	MetricVideoViewsP25           = "video_views_p25"
+	MetricVideoViewsP50           = "video_views_p50"
+	MetricVideoViewsP75           = "video_views_p75"
+	MetricVideoViewsP100          = "video_views_p100"
+	MetricAverageVideoPlayPerUser = "average_video_play_per_user"
+)
+
+// VideoMetrics returns the watch-time and quartile metrics, for use in
+// IntegratedGetRequest.Metrics alongside basic metrics such as "spend"
+func VideoMetrics() []string {
+	return []string{
+		MetricVideoWatched2s,
+		MetricVideoWatched6s,
+		MetricVideoViewsP25,
+		MetricVideoViewsP50,
+		MetricVideoViewsP75,
+		MetricVideoViewsP100,
+		MetricAverageVideoPlayPerUser,
+	}
+}
@@ -0,0 +1,69 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetIntegratedReport_VideoMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var metrics []string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("metrics")), &metrics))
+		assert.Equal(t, []string{
+			"spend",
+			"video_watched_2s",
+			"video_watched_6s",
+			"video_views_p25",
+			"video_views_p50",
+			"video_views_p75",
+			"video_views_p100",
+			"average_video_play_per_user",
+		}, metrics)
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"dimensions": map[string]interface{}{"ad_id": "123"},
+						"metrics":    map[string]interface{}{"spend": "10.00", "video_views_p25": "400", "video_views_p100": "90"},
+					},
+				},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 1, "total_page": 1},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123"
+	dataLevel := DataLevelAd
+	resp, err := api.GetIntegratedReport(context.Background(), &IntegratedGetRequest{
+		ReportType:   ReportTypeBasic,
+		AdvertiserID: &advertiserID,
+		DataLevel:    &dataLevel,
+		Dimensions:   []string{"ad_id"},
+		Metrics:      append([]string{"spend"}, VideoMetrics()...),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.List, 1)
+	assert.Equal(t, "400", resp.List[0]["metrics"].(map[string]interface{})["video_views_p25"])
+}
+
+func TestVideoMetrics_ReturnsCopy(t *testing.T) {
+	metrics := VideoMetrics()
+	metrics[0] = "changed"
+	assert.Equal(t, MetricVideoWatched2s, VideoMetrics()[0])
+}
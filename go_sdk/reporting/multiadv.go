@@ -0,0 +1,132 @@
+package reporting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// maxAdvertisersPerReport is the number of advertiser IDs accepted by one report request
+const maxAdvertisersPerReport = 5
+
+// maxConcurrentReports is the number of advertiser chunks fetched at the same time
+const maxConcurrentReports = 4
+
+// MultiAdvReportResponse represents an integrated report covering several advertisers
+type MultiAdvReportResponse struct {
+	IntegratedGetResponse
+}
+
+// RowsByAdvertiser groups report rows by advertiser ID.
+// The ID is read from the row itself or from its "dimensions" object;
+// rows without one are grouped under "".
+func (r *MultiAdvReportResponse) RowsByAdvertiser() map[string][]map[string]interface{} {
+	rows := make(map[string][]map[string]interface{})
+	for _, row := range r.List {
+		id := rowAdvertiserID(row)
+		rows[id] = append(rows[id], row)
+	}
+	return rows
+}
+
+// rowAdvertiserID extracts advertiser_id from a flat or dimension-nested report row
+func rowAdvertiserID(row map[string]interface{}) string {
+	if id, ok := row["advertiser_id"].(string); ok {
+		return id
+	}
+	if dims, ok := row["dimensions"].(map[string]interface{}); ok {
+		if id, ok := dims["advertiser_id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// GetIntegratedReportMultiAdv runs a synchronous report across the advertisers in req.AdvertiserIDs.
+// Include "advertiser_id" in Dimensions to attribute rows to advertisers, and set
+// MultiAdvReportInUTCTime when the advertisers use different timezones.
+//
+// More than 5 advertisers are split into chunks of 5 that are fetched concurrently and
+// merged in advertiser order. Page and PageSize apply to each chunk, and TotalMetrics
+// is only returned when a single request was needed.
+func (a *API) GetIntegratedReportMultiAdv(ctx context.Context, req *IntegratedGetRequest) (*MultiAdvReportResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+	if len(req.AdvertiserIDs) == 0 {
+		return nil, errors.New("advertiser IDs are required for a multi-advertiser report")
+	}
+	if req.AdvertiserID != nil {
+		return nil, errors.New("advertiser ID must not be set for a multi-advertiser report")
+	}
+
+	if len(req.AdvertiserIDs) <= maxAdvertisersPerReport {
+		resp, err := a.GetIntegratedReport(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &MultiAdvReportResponse{IntegratedGetResponse: *resp}, nil
+	}
+
+	var chunks [][]string
+	for start := 0; start < len(req.AdvertiserIDs); start += maxAdvertisersPerReport {
+		end := start + maxAdvertisersPerReport
+		if end > len(req.AdvertiserIDs) {
+			end = len(req.AdvertiserIDs)
+		}
+		chunks = append(chunks, req.AdvertiserIDs[start:end])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*IntegratedGetResponse, len(chunks))
+	sem := make(chan struct{}, maxConcurrentReports)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk []string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			chunkReq := *req
+			chunkReq.AdvertiserIDs = chunk
+			resp, err := a.GetIntegratedReport(ctx, &chunkReq)
+			if err != nil {
+				// Keep the first failure; later ones are usually the cancellation it caused
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("failed to get report for advertisers %v: %w", chunk, err)
+					cancel()
+				})
+				return
+			}
+			results[i] = resp
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	merged := &MultiAdvReportResponse{}
+	for _, resp := range results {
+		merged.List = append(merged.List, resp.List...)
+		merged.PageInfo.TotalNumber += resp.PageInfo.TotalNumber
+		if resp.PageInfo.TotalPage > merged.PageInfo.TotalPage {
+			merged.PageInfo.TotalPage = resp.PageInfo.TotalPage
+		}
+		merged.PageInfo.Page = resp.PageInfo.Page
+		merged.PageInfo.PageSize = resp.PageInfo.PageSize
+	}
+
+	return merged, nil
+}
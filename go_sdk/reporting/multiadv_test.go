@@ -0,0 +1,203 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetIntegratedReportMultiAdv(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/open_api/v1.3/report/integrated/get/", r.URL.Path)
+
+		query := r.URL.Query()
+		assert.Equal(t, `["111","222"]`, query.Get("advertiser_ids"))
+		assert.Len(t, query["advertiser_ids"], 1)
+		assert.Empty(t, query.Get("advertiser_id"))
+		assert.Equal(t, "true", query.Get("multi_adv_report_in_utc_time"))
+		assert.Equal(t, `["advertiser_id","stat_time_day"]`, query.Get("dimensions"))
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"dimensions": map[string]interface{}{"advertiser_id": "111", "stat_time_day": "2024-01-01 00:00:00"},
+						"metrics":    map[string]interface{}{"spend": "10.00"},
+					},
+					{
+						"dimensions": map[string]interface{}{"advertiser_id": "222", "stat_time_day": "2024-01-01 00:00:00"},
+						"metrics":    map[string]interface{}{"spend": "20.00"},
+					},
+					{
+						"dimensions": map[string]interface{}{"advertiser_id": "111", "stat_time_day": "2024-01-02 00:00:00"},
+						"metrics":    map[string]interface{}{"spend": "30.00"},
+					},
+				},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 3, "total_page": 1},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	serviceType := ServiceTypeAuction
+	dataLevel := DataLevelAdvertiser
+	startDate := "2024-01-01"
+	endDate := "2024-01-02"
+	utc := true
+
+	resp, err := api.GetIntegratedReportMultiAdv(context.Background(), &IntegratedGetRequest{
+		ReportType:              ReportTypeBasic,
+		AdvertiserIDs:           []string{"111", "222"},
+		ServiceType:             &serviceType,
+		DataLevel:               &dataLevel,
+		Dimensions:              []string{"advertiser_id", "stat_time_day"},
+		Metrics:                 []string{"spend"},
+		StartDate:               &startDate,
+		EndDate:                 &endDate,
+		MultiAdvReportInUTCTime: &utc,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.List, 3)
+
+	rows := resp.RowsByAdvertiser()
+	assert.Len(t, rows["111"], 2)
+	assert.Len(t, rows["222"], 1)
+	assert.Equal(t, "20.00", rows["222"][0]["metrics"].(map[string]interface{})["spend"])
+}
+
+func TestGetIntegratedReportMultiAdv_Validation(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+	advertiserID := "111"
+
+	_, err := api.GetIntegratedReportMultiAdv(context.Background(), &IntegratedGetRequest{ReportType: ReportTypeBasic})
+	assert.Error(t, err)
+
+	_, err = api.GetIntegratedReportMultiAdv(context.Background(), &IntegratedGetRequest{
+		ReportType:    ReportTypeBasic,
+		AdvertiserID:  &advertiserID,
+		AdvertiserIDs: []string{"111", "222"},
+	})
+	assert.Error(t, err)
+}
+
+func TestGetIntegratedReportMultiAdv_Chunked(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		chunks [][]string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ids []string
+		require.NoError(t, json.Unmarshal([]byte(r.URL.Query().Get("advertiser_ids")), &ids))
+		mu.Lock()
+		chunks = append(chunks, ids)
+		mu.Unlock()
+
+		// One row per advertiser in the chunk
+		var list []map[string]interface{}
+		for _, id := range ids {
+			list = append(list, map[string]interface{}{
+				"dimensions": map[string]interface{}{"advertiser_id": id},
+				"metrics":    map[string]interface{}{"spend": "1.00"},
+			})
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list":          list,
+				"page_info":     map[string]interface{}{"page": 1, "page_size": 10, "total_number": len(ids), "total_page": 1},
+				"total_metrics": map[string]interface{}{"spend": "5.00"},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	var advertiserIDs []string
+	for i := 1; i <= 12; i++ {
+		advertiserIDs = append(advertiserIDs, fmt.Sprintf("adv%02d", i))
+	}
+
+	resp, err := api.GetIntegratedReportMultiAdv(context.Background(), &IntegratedGetRequest{
+		ReportType:    ReportTypeBasic,
+		AdvertiserIDs: advertiserIDs,
+		Dimensions:    []string{"advertiser_id"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, chunks, 3)
+	var sizes []int
+	for _, c := range chunks {
+		sizes = append(sizes, len(c))
+	}
+	assert.ElementsMatch(t, []int{5, 5, 2}, sizes)
+
+	require.Len(t, resp.List, 12)
+	assert.Equal(t, int64(12), resp.PageInfo.TotalNumber)
+	assert.Nil(t, resp.TotalMetrics)
+
+	// Rows are merged in advertiser order regardless of which chunk finished first
+	rows := resp.RowsByAdvertiser()
+	assert.Len(t, rows, 12)
+	assert.Equal(t, "adv01", rowAdvertiserID(resp.List[0]))
+	assert.Equal(t, "adv12", rowAdvertiserID(resp.List[11]))
+}
+
+func TestGetIntegratedReportMultiAdv_ChunkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := map[string]interface{}{
+			"code":    40001,
+			"message": "invalid advertiser",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetIntegratedReportMultiAdv(context.Background(), &IntegratedGetRequest{
+		ReportType:    ReportTypeBasic,
+		AdvertiserIDs: []string{"1", "2", "3", "4", "5", "6"},
+	})
+	require.Error(t, err)
+
+	var apiErr *tiktok.ErrorResponse
+	assert.ErrorAs(t, err, &apiErr)
+}
+
+func TestMultiAdvReportResponse_RowsByAdvertiser_FlatRows(t *testing.T) {
+	resp := &MultiAdvReportResponse{IntegratedGetResponse: IntegratedGetResponse{
+		List: []map[string]interface{}{
+			{"advertiser_id": "111", "spend": 1.0},
+			{"spend": 2.0},
+		},
+	}}
+
+	rows := resp.RowsByAdvertiser()
+	assert.Len(t, rows["111"], 1)
+	assert.Len(t, rows[""], 1)
+}
@@ -2,6 +2,7 @@ package reporting
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -54,17 +55,26 @@ type IntegratedGetResponse struct {
 // GetIntegratedReport runs a synchronous report.
 // Reference: https://business-api.tiktok.com/portal/docs?id=1740302848100353
 func (a *API) GetIntegratedReport(ctx context.Context, req *IntegratedGetRequest) (*IntegratedGetResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("report_type", req.ReportType)
 
 	if req.AdvertiserID != nil {
 		params.Set("advertiser_id", *req.AdvertiserID)
+	} else if advertiserID, ok := tiktok.AdvertiserIDFromContext(ctx); ok && len(req.AdvertiserIDs) == 0 && req.BcID == nil {
+		params.Set("advertiser_id", advertiserID)
 	}
 
-	if len(req.AdvertiserIDs) > 0 {
-		for _, id := range req.AdvertiserIDs {
-			params.Add("advertiser_ids", id)
-		}
+	// Add advertiser IDs as JSON array
+	if err := tiktok.AddStringSlice(params, "advertiser_ids", req.AdvertiserIDs); err != nil {
+		return nil, err
 	}
 
 	if req.BcID != nil {
@@ -102,7 +112,7 @@ func (a *API) GetIntegratedReport(ctx context.Context, req *IntegratedGetRequest
 	}
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -154,7 +164,7 @@ type TaskCheckResponse struct {
 func (a *API) CheckReportTask(ctx context.Context, taskID, advertiserID string) (*TaskCheckResponse, error) {
 	params := url.Values{}
 	params.Set("task_id", taskID)
-	params.Set("advertiser_id", advertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
 
 	// Use generic DoGet helper
 	var resp TaskCheckResponse
@@ -165,6 +175,44 @@ func (a *API) CheckReportTask(ctx context.Context, taskID, advertiserID string)
 	return &resp, nil
 }
 
+// ErrReportTaskCompleted is returned when cancelling a report task that has already finished
+var ErrReportTaskCompleted = errors.New("report task already completed")
+
+// taskCancelRequest represents the request body for cancelling a report task
+type taskCancelRequest struct {
+	AdvertiserID string `json:"advertiser_id"`
+	TaskID       string `json:"task_id"`
+}
+
+// CancelReportTask cancels an async report task.
+// If the API rejects the cancel because the task has already finished,
+// ErrReportTaskCompleted is returned.
+// Reference: https://business-api.tiktok.com/portal/docs?id=1803615367145537
+func (a *API) CancelReportTask(ctx context.Context, taskID, advertiserID string) error {
+	body := taskCancelRequest{
+		AdvertiserID: tiktok.ResolveAdvertiserID(ctx, advertiserID),
+		TaskID:       taskID,
+	}
+
+	var resp struct{}
+	if err := tiktok.DoPost(ctx, a.client, "/open_api/v1.3/report/task/cancel/", body, &resp); err != nil {
+		var apiErr *tiktok.ErrorResponse
+		if errors.As(err, &apiErr) {
+			if task, checkErr := a.CheckReportTask(ctx, taskID, advertiserID); checkErr == nil && isTaskFinished(task.Status) {
+				return fmt.Errorf("failed to cancel report task %s: %w", taskID, ErrReportTaskCompleted)
+			}
+		}
+		return fmt.Errorf("failed to cancel report task: %w", err)
+	}
+
+	return nil
+}
+
+// isTaskFinished reports whether an async report task status is terminal-successful
+func isTaskFinished(status string) bool {
+	return status == "SUCCESS" || status == "COMPLETED"
+}
+
 // MaterialReportBreakdownRequest represents the request for Smart Plus material report breakdown
 type MaterialReportBreakdownRequest struct {
 	AdvertiserID string      `json:"advertiser_id"`
@@ -188,8 +236,12 @@ type MaterialReportResponse struct {
 // GetMaterialReportBreakdown gets breakdown of Smart Plus material reports.
 // Reference: https://business-api.tiktok.com/portal/docs?id=1765936670832641
 func (a *API) GetMaterialReportBreakdown(ctx context.Context, req *MaterialReportBreakdownRequest) (*MaterialReportResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 	params.Set("start_date", req.StartDate)
 	params.Set("end_date", req.EndDate)
 
@@ -217,7 +269,7 @@ func (a *API) GetMaterialReportBreakdown(ctx context.Context, req *MaterialRepor
 	}
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -249,8 +301,12 @@ type MaterialReportOverviewRequest struct {
 // GetMaterialReportOverview gets overview of Smart Plus material reports.
 // Reference: https://business-api.tiktok.com/portal/docs?id=1765936643763201
 func (a *API) GetMaterialReportOverview(ctx context.Context, req *MaterialReportOverviewRequest) (*MaterialReportResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
-	params.Set("advertiser_id", req.AdvertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, req.AdvertiserID))
 
 	// Add dimensions as JSON array
 	if err := tiktok.AddStringSlice(params, "dimensions", req.Dimensions); err != nil {
@@ -288,7 +344,7 @@ func (a *API) GetMaterialReportOverview(ctx context.Context, req *MaterialReport
 	}
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
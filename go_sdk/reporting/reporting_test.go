@@ -226,3 +226,105 @@ func TestGetMaterialReportOverview(t *testing.T) {
 	assert.Equal(t, float64(1000.00), resp.List[0]["total_spend"])
 	assert.Equal(t, int64(1), resp.PageInfo.Page)
 }
+
+func TestCancelReportTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/open_api/v1.3/report/task/cancel/", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "task123", body["task_id"])
+		assert.Equal(t, "123456", body["advertiser_id"])
+
+		response := map[string]interface{}{
+			"code":       0,
+			"message":    "OK",
+			"request_id": "test_request_id",
+			"data":       map[string]interface{}{},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.CancelReportTask(context.Background(), "task123", "123456")
+	require.NoError(t, err)
+}
+
+func TestCancelReportTask_AlreadyCompleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response map[string]interface{}
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/task/cancel/":
+			response = map[string]interface{}{
+				"code":       40002,
+				"message":    "Task status does not allow cancellation",
+				"request_id": "test_request_id",
+			}
+		case "/open_api/v1.3/report/task/check/":
+			response = map[string]interface{}{
+				"code":       0,
+				"message":    "OK",
+				"request_id": "test_request_id",
+				"data": map[string]interface{}{
+					"task_id": "task123",
+					"status":  "SUCCESS",
+				},
+			}
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.CancelReportTask(context.Background(), "task123", "123456")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrReportTaskCompleted)
+}
+
+func TestCancelReportTask_OtherError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response map[string]interface{}
+		switch r.URL.Path {
+		case "/open_api/v1.3/report/task/cancel/":
+			response = map[string]interface{}{
+				"code":       40002,
+				"message":    "Invalid task",
+				"request_id": "test_request_id",
+			}
+		default:
+			response = map[string]interface{}{
+				"code":       0,
+				"message":    "OK",
+				"request_id": "test_request_id",
+				"data": map[string]interface{}{
+					"task_id": "task123",
+					"status":  "PROCESSING",
+				},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	err := api.CancelReportTask(context.Background(), "task123", "123456")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrReportTaskCompleted)
+	assert.Contains(t, err.Error(), "Invalid task")
+}
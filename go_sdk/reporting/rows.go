@@ -0,0 +1,93 @@
+package reporting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// CampaignReportRow is a campaign-level report row with typed metrics.
+// Metrics returned as strings (the usual case) or numbers both decode.
+type CampaignReportRow struct {
+	CampaignID   string             `json:"campaign_id"`
+	CampaignName string             `json:"campaign_name,omitempty"`
+	StatTimeDay  string             `json:"stat_time_day,omitempty"`
+	Spend        tiktok.FlexFloat64 `json:"spend"`
+	Impressions  tiktok.FlexInt64   `json:"impressions"`
+	Clicks       tiktok.FlexInt64   `json:"clicks"`
+	CTR          tiktok.FlexFloat64 `json:"ctr"`
+	Conversions  tiktok.FlexInt64   `json:"conversion"`
+}
+
+// DecodeCampaignRows decodes the rows of a campaign-level report, such as one
+// returned by CampaignReport. Request the "spend", "impressions", "clicks", "ctr"
+// and "conversion" metrics; metrics not in the report are left at zero.
+func DecodeCampaignRows(resp *IntegratedGetResponse) ([]CampaignReportRow, error) {
+	if resp == nil {
+		return nil, nil
+	}
+	return decodeRows[CampaignReportRow](resp.List)
+}
+
+// ErrNoTotalMetrics is returned when decoding the totals of a report that has none;
+// totals are only returned when EnableTotalMetrics is set
+var ErrNoTotalMetrics = errors.New("report has no total metrics")
+
+// DecodeTotalMetrics decodes the TotalMetrics of a report run with EnableTotalMetrics
+// into T, typically a struct of tiktok.FlexFloat64 / tiktok.FlexInt64 fields tagged
+// with metric names, since totals are returned as strings.
+func DecodeTotalMetrics[T any](resp *IntegratedGetResponse) (T, error) {
+	var totals T
+	if resp == nil || resp.TotalMetrics == nil {
+		return totals, ErrNoTotalMetrics
+	}
+
+	data, err := json.Marshal(resp.TotalMetrics)
+	if err != nil {
+		return totals, fmt.Errorf("failed to decode total metrics: %w", err)
+	}
+	if err := json.Unmarshal(data, &totals); err != nil {
+		return totals, fmt.Errorf("failed to decode total metrics: %w", err)
+	}
+	return totals, nil
+}
+
+// decodeRows decodes report rows into T, reading dimensions and metrics as a
+// single flat object
+func decodeRows[T any](list []map[string]interface{}) ([]T, error) {
+	rows := make([]T, 0, len(list))
+	for i, row := range list {
+		data, err := json.Marshal(flattenRow(row))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode report row %d: %w", i, err)
+		}
+		var decoded T
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil, fmt.Errorf("failed to decode report row %d: %w", i, err)
+		}
+		rows = append(rows, decoded)
+	}
+	return rows, nil
+}
+
+// flattenRow merges the "dimensions" and "metrics" objects of a report row
+// into the row's top-level fields
+func flattenRow(row map[string]interface{}) map[string]interface{} {
+	flat := make(map[string]interface{}, len(row))
+	for key, value := range row {
+		if key == "dimensions" || key == "metrics" {
+			continue
+		}
+		flat[key] = value
+	}
+	for _, key := range []string{"dimensions", "metrics"} {
+		if nested, ok := row[key].(map[string]interface{}); ok {
+			for k, v := range nested {
+				flat[k] = v
+			}
+		}
+	}
+	return flat
+}
@@ -0,0 +1,126 @@
+package reporting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestDecodeCampaignRows(t *testing.T) {
+	var resp IntegratedGetResponse
+	err := json.Unmarshal([]byte(`{
+		"list": [
+			{
+				"dimensions": {"campaign_id": "1800000000000001", "stat_time_day": "2024-01-01 00:00:00"},
+				"metrics": {
+					"campaign_name": "Spring Sale",
+					"spend": "1234.56",
+					"impressions": "100000",
+					"clicks": 2500,
+					"ctr": "2.50",
+					"conversion": "120"
+				}
+			},
+			{
+				"dimensions": {"campaign_id": "1800000000000002", "stat_time_day": "2024-01-01 00:00:00"},
+				"metrics": {
+					"spend": 0,
+					"impressions": "",
+					"clicks": "0",
+					"ctr": 0.0
+				}
+			}
+		],
+		"page_info": {"page": 1, "page_size": 10, "total_number": 2, "total_page": 1}
+	}`), &resp)
+	require.NoError(t, err)
+
+	rows, err := DecodeCampaignRows(&resp)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, "1800000000000001", rows[0].CampaignID)
+	assert.Equal(t, "Spring Sale", rows[0].CampaignName)
+	assert.Equal(t, "2024-01-01 00:00:00", rows[0].StatTimeDay)
+	assert.Equal(t, 1234.56, rows[0].Spend.Float64())
+	assert.Equal(t, int64(100000), rows[0].Impressions.Int64())
+	assert.Equal(t, int64(2500), rows[0].Clicks.Int64())
+	assert.Equal(t, 2.5, rows[0].CTR.Float64())
+	assert.Equal(t, int64(120), rows[0].Conversions.Int64())
+
+	assert.Equal(t, "1800000000000002", rows[1].CampaignID)
+	assert.Equal(t, 0.0, rows[1].Spend.Float64())
+	assert.Equal(t, int64(0), rows[1].Impressions.Int64())
+	assert.Equal(t, int64(0), rows[1].Conversions.Int64())
+}
+
+func TestDecodeCampaignRows_InvalidMetric(t *testing.T) {
+	resp := &IntegratedGetResponse{
+		List: []map[string]interface{}{
+			{"dimensions": map[string]interface{}{"campaign_id": "1"}, "metrics": map[string]interface{}{"spend": "n/a"}},
+		},
+	}
+
+	_, err := DecodeCampaignRows(resp)
+	assert.Error(t, err)
+}
+
+func TestDecodeTotalMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("enable_total_metrics"))
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list": []map[string]interface{}{
+					{
+						"dimensions": map[string]interface{}{"campaign_id": "1"},
+						"metrics":    map[string]interface{}{"spend": "10.00", "impressions": "1000"},
+					},
+				},
+				"page_info":     map[string]interface{}{"page": 1, "page_size": 10, "total_number": 1, "total_page": 1},
+				"total_metrics": map[string]interface{}{"spend": "1234.56", "impressions": "100000"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	advertiserID := "123"
+	dataLevel := DataLevelCampaign
+	enableTotals := true
+	resp, err := api.GetIntegratedReport(context.Background(), &IntegratedGetRequest{
+		ReportType:         ReportTypeBasic,
+		AdvertiserID:       &advertiserID,
+		DataLevel:          &dataLevel,
+		Dimensions:         []string{"campaign_id"},
+		Metrics:            []string{"spend", "impressions"},
+		EnableTotalMetrics: &enableTotals,
+	})
+	require.NoError(t, err)
+
+	type totals struct {
+		Spend       tiktok.FlexFloat64 `json:"spend"`
+		Impressions tiktok.FlexInt64   `json:"impressions"`
+	}
+	got, err := DecodeTotalMetrics[totals](resp)
+	require.NoError(t, err)
+	assert.Equal(t, 1234.56, got.Spend.Float64())
+	assert.Equal(t, int64(100000), got.Impressions.Int64())
+}
+
+func TestDecodeTotalMetrics_NoTotals(t *testing.T) {
+	_, err := DecodeTotalMetrics[map[string]string](&IntegratedGetResponse{})
+	assert.ErrorIs(t, err, ErrNoTotalMetrics)
+}
@@ -0,0 +1,76 @@
+package reporting
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// reportDateLayout is the date format expected by report start_date/end_date
+const reportDateLayout = "2006-01-02"
+
+// LocalDateRange is a report date range resolved in an advertiser's timezone
+type LocalDateRange struct {
+	DateRange
+	// Warnings describes DST edge cases where the dates may not cover the
+	// exact instants requested
+	Warnings []string
+}
+
+// DateRangeInTimezone converts the instants [start, end] into report dates in
+// the given IANA timezone (AdvertiserInfo.Timezone from account.GetAdvertiserInfo).
+// Reports are bucketed by day in the account timezone, so a UTC range can shift
+// by a day at either boundary.
+func DateRangeInTimezone(start, end time.Time, timezone string) (*LocalDateRange, error) {
+	if end.Before(start) {
+		return nil, errors.New("end is before start")
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone %q: %w", timezone, err)
+	}
+
+	localStart := start.In(loc)
+	localEnd := end.In(loc)
+
+	r := &LocalDateRange{
+		DateRange: DateRange{
+			StartDate: localStart.Format(reportDateLayout),
+			EndDate:   localEnd.Format(reportDateLayout),
+		},
+	}
+
+	_, startOffset := localStart.Zone()
+	_, endOffset := localEnd.Zone()
+	if startOffset != endOffset {
+		r.Warnings = append(r.Warnings, fmt.Sprintf("range crosses a DST transition in %s (UTC offset changes from %s to %s)",
+			timezone, formatOffset(startOffset), formatOffset(endOffset)))
+	}
+	for _, t := range []time.Time{localStart, localEnd} {
+		if isTransitionDay(t) {
+			r.Warnings = append(r.Warnings, fmt.Sprintf("%s is a DST transition day in %s and is not 24 hours long",
+				t.Format(reportDateLayout), timezone))
+		}
+	}
+
+	return r, nil
+}
+
+// isTransitionDay reports whether the local calendar day containing t is not 24 hours long
+func isTransitionDay(t time.Time) bool {
+	y, m, d := t.Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	nextDay := time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	return nextDay.Sub(dayStart) != 24*time.Hour
+}
+
+// formatOffset formats a UTC offset in seconds as +hh:mm
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
@@ -0,0 +1,60 @@
+package reporting
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateRangeInTimezone_PlusNine(t *testing.T) {
+	// 2024-01-01 15:00 UTC is already 2024-01-02 00:00 in Tokyo
+	start := time.Date(2024, 1, 1, 15, 0, 0, 0, time.UTC)
+	// 2024-01-31 14:59 UTC is still 2024-01-31 23:59 in Tokyo
+	end := time.Date(2024, 1, 31, 14, 59, 0, 0, time.UTC)
+
+	r, err := DateRangeInTimezone(start, end, "Asia/Tokyo")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-01-02", r.StartDate)
+	assert.Equal(t, "2024-01-31", r.EndDate)
+	assert.Empty(t, r.Warnings)
+}
+
+func TestDateRangeInTimezone_EndShiftsToNextDay(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC)
+
+	r, err := DateRangeInTimezone(start, end, "Etc/GMT-9")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-01-01", r.StartDate)
+	assert.Equal(t, "2024-02-01", r.EndDate)
+}
+
+func TestDateRangeInTimezone_DSTWarnings(t *testing.T) {
+	// US DST starts on 2024-03-10
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	r, err := DateRangeInTimezone(start, end, "America/New_York")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-03-01", r.StartDate)
+	assert.Equal(t, "2024-03-10", r.EndDate)
+	require.Len(t, r.Warnings, 2)
+	assert.Contains(t, r.Warnings[0], "-05:00 to -04:00")
+	assert.Contains(t, r.Warnings[1], "2024-03-10")
+}
+
+func TestDateRangeInTimezone_Errors(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := DateRangeInTimezone(now, now.Add(-time.Hour), "UTC")
+	assert.Error(t, err)
+
+	_, err = DateRangeInTimezone(now, now, "Not/AZone")
+	assert.Error(t, err)
+}
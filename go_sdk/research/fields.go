@@ -0,0 +1,57 @@
+package research
+
+// Field names accepted by the fields parameter of GetAdReport.
+// Each constant matches the json tag of the corresponding AdReportData field.
+const (
+	FieldAdID             = "ad_id"
+	FieldAdName           = "ad_name"
+	FieldAdvertiserID     = "advertiser_id"
+	FieldAdvertiserName   = "advertiser_name"
+	FieldCampaignID       = "campaign_id"
+	FieldCampaignName     = "campaign_name"
+	FieldAdgroupID        = "adgroup_id"
+	FieldAdgroupName      = "adgroup_name"
+	FieldCountry          = "country"
+	FieldRegion           = "region"
+	FieldLanguage         = "language"
+	FieldPlatform         = "platform"
+	FieldObjectiveType    = "objective_type"
+	FieldCallToAction     = "call_to_action"
+	FieldVideoID          = "video_id"
+	FieldVideoTitle       = "video_title"
+	FieldVideoDuration    = "video_duration"
+	FieldThumbnailURL     = "thumbnail_url"
+	FieldLandingPageURL   = "landing_page_url"
+	FieldDisplayName      = "display_name"
+	FieldProfileImage     = "profile_image"
+	FieldAdText           = "ad_text"
+	FieldImpressions      = "impressions"
+	FieldClicks           = "clicks"
+	FieldCTR              = "ctr"
+	FieldReach            = "reach"
+	FieldFrequency        = "frequency"
+	FieldLikes            = "likes"
+	FieldComments         = "comments"
+	FieldShares           = "shares"
+	FieldVideoViews       = "video_views"
+	FieldVideoViewRate    = "video_view_rate"
+	FieldAverageVideoPlay = "average_video_play"
+	FieldFirstShownDate   = "first_shown_date"
+	FieldLastShownDate    = "last_shown_date"
+	FieldStatTimePeriod   = "stat_time_period"
+)
+
+// AllAdReportFields returns every field that can be requested from GetAdReport
+func AllAdReportFields() []string {
+	return []string{
+		FieldAdID, FieldAdName, FieldAdvertiserID, FieldAdvertiserName,
+		FieldCampaignID, FieldCampaignName, FieldAdgroupID, FieldAdgroupName,
+		FieldCountry, FieldRegion, FieldLanguage, FieldPlatform,
+		FieldObjectiveType, FieldCallToAction, FieldVideoID, FieldVideoTitle,
+		FieldVideoDuration, FieldThumbnailURL, FieldLandingPageURL, FieldDisplayName,
+		FieldProfileImage, FieldAdText, FieldImpressions, FieldClicks,
+		FieldCTR, FieldReach, FieldFrequency, FieldLikes,
+		FieldComments, FieldShares, FieldVideoViews, FieldVideoViewRate,
+		FieldAverageVideoPlay, FieldFirstShownDate, FieldLastShownDate, FieldStatTimePeriod,
+	}
+}
@@ -0,0 +1,105 @@
+package research
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// fullAdReportRow returns a response row with a non-zero value for every field
+func fullAdReportRow() map[string]interface{} {
+	row := map[string]interface{}{}
+	for _, field := range AllAdReportFields() {
+		row[field] = "value_" + field
+	}
+	for _, field := range []string{
+		FieldImpressions, FieldClicks, FieldReach, FieldLikes,
+		FieldComments, FieldShares, FieldVideoViews,
+	} {
+		row[field] = 42
+	}
+	for _, field := range []string{
+		FieldVideoDuration, FieldCTR, FieldFrequency, FieldVideoViewRate, FieldAverageVideoPlay,
+	} {
+		row[field] = 1.5
+	}
+	return row
+}
+
+func TestAllAdReportFields_MatchStruct(t *testing.T) {
+	fields := map[string]bool{}
+	for _, field := range AllAdReportFields() {
+		if fields[field] {
+			t.Errorf("Duplicate field %s", field)
+		}
+		fields[field] = true
+	}
+
+	typ := reflect.TypeOf(AdReportData{})
+	if typ.NumField() != len(fields) {
+		t.Errorf("Expected %d fields, AdReportData has %d", len(fields), typ.NumField())
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		tag := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		if !fields[tag] {
+			t.Errorf("AdReportData field %s has no field constant", tag)
+		}
+	}
+}
+
+func TestGetAdReport_AllFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var fields []string
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("fields")), &fields); err != nil {
+			t.Fatalf("Failed to decode fields: %v", err)
+		}
+		if !reflect.DeepEqual(fields, AllAdReportFields()) {
+			t.Errorf("Expected all fields to be requested, got %v", fields)
+		}
+
+		response := map[string]interface{}{
+			"code": 0,
+			"data": map[string]interface{}{
+				"list":      []map[string]interface{}{fullAdReportRow()},
+				"page_info": map[string]interface{}{"page": 1, "page_size": 10, "total_number": 1, "total_page": 1},
+			},
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_token", server.URL, nil)
+	api := NewAPI(client)
+
+	resp, err := api.GetAdReport(context.Background(), &GetAdReportRequest{
+		SearchTerm: "shoes",
+		Fields:     AllAdReportFields(),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(resp.List) != 1 {
+		t.Fatalf("Expected 1 ad, got %d", len(resp.List))
+	}
+
+	// Round-trip the parsed row: any key the struct dropped would be missing here
+	encoded, err := json.Marshal(resp.List[0])
+	if err != nil {
+		t.Fatalf("Failed to marshal ad: %v", err)
+	}
+	var roundTrip map[string]interface{}
+	if err := json.Unmarshal(encoded, &roundTrip); err != nil {
+		t.Fatalf("Failed to unmarshal ad: %v", err)
+	}
+	for key := range fullAdReportRow() {
+		if _, ok := roundTrip[key]; !ok {
+			t.Errorf("Response key %s was silently dropped", key)
+		}
+	}
+}
@@ -110,6 +110,10 @@ type GetAdReportRequest struct {
 // This API provides access to TikTok's ad library for research purposes
 // Reference: https://business-api.tiktok.com/portal/docs?id=1758579480845313
 func (a *API) GetAdReport(ctx context.Context, req *GetAdReportRequest) (*GetAdReportResponse, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+
 	params := url.Values{}
 	params.Set("search_term", req.SearchTerm)
 
@@ -119,7 +123,7 @@ func (a *API) GetAdReport(ctx context.Context, req *GetAdReportRequest) (*GetAdR
 	}
 
 	// Add pagination using helper
-	tiktok.AddPagination(params, &tiktok.PaginationParams{
+	a.client.AddPagination(params, &tiktok.PaginationParams{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 	})
@@ -154,31 +158,19 @@ func (a *API) GetAdReport(ctx context.Context, req *GetAdReportRequest) (*GetAdR
 // GetAllAdReports retrieves all ad reports by automatically handling pagination
 // This is a convenience method that calls GetAdReport multiple times if needed
 func (a *API) GetAllAdReports(ctx context.Context, req *GetAdReportRequest) ([]AdReportData, error) {
-	var allReports []AdReportData
-	page := int64(1)
-	pageSize := int64(100) // Use maximum page size for efficiency
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
 
-	// Override pagination parameters in request
-	req.Page = &page
-	req.PageSize = &pageSize
+	// Use maximum page size for efficiency
+	return tiktok.Paginate(ctx, tiktok.MaxPageSize, func(ctx context.Context, page, pageSize int64) ([]AdReportData, tiktok.PageInfo, error) {
+		req.Page = &page
+		req.PageSize = &pageSize
 
-	for {
 		resp, err := a.GetAdReport(ctx, req)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get ad report page %d: %w", page, err)
-		}
-
-		allReports = append(allReports, resp.List...)
-
-		// Check if we've fetched all pages
-		if page >= resp.PageInfo.TotalPage {
-			break
+			return nil, tiktok.PageInfo{}, fmt.Errorf("failed to get ad report page %d: %w", page, err)
 		}
-
-		// Move to next page
-		page++
-		req.Page = &page
-	}
-
-	return allReports, nil
+		return resp.List, resp.PageInfo, nil
+	})
 }
@@ -0,0 +1,48 @@
+package research
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+// watermarkLayout is the date format of first_shown_date and its filters
+const watermarkLayout = "2006-01-02"
+
+// GetAdReportSince gets all ads first shown on or after sinceDate (YYYY-MM-DD),
+// for monitoring the ad library incrementally: pass the date of the previous run
+// to get only the ads that appeared since. sinceDate overrides any FirstShownDateMin
+// in req.Filtering; req itself is not modified.
+func (a *API) GetAdReportSince(ctx context.Context, req *GetAdReportRequest, sinceDate string) ([]AdReportData, error) {
+	if req == nil {
+		return nil, tiktok.ErrNilRequest
+	}
+	if _, err := time.Parse(watermarkLayout, sinceDate); err != nil {
+		return nil, fmt.Errorf("invalid since date %q: %w", sinceDate, err)
+	}
+
+	sinceReq := *req
+	var filtering AdReportFiltering
+	if req.Filtering != nil {
+		filtering = *req.Filtering
+	}
+	filtering.FirstShownDateMin = &sinceDate
+	sinceReq.Filtering = &filtering
+
+	ads, err := a.GetAllAdReports(ctx, &sinceReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-check locally; ads without a first shown date (field not requested) are kept
+	newAds := make([]AdReportData, 0, len(ads))
+	for _, ad := range ads {
+		if ad.FirstShownDate == "" || ad.FirstShownDate >= sinceDate {
+			newAds = append(newAds, ad)
+		}
+	}
+
+	return newAds, nil
+}
@@ -0,0 +1,96 @@
+package research
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetAdReportSince(t *testing.T) {
+	callCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+
+		var filtering map[string]interface{}
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("filtering")), &filtering); err != nil {
+			t.Fatalf("Failed to decode filtering: %v", err)
+		}
+		if filtering["first_shown_date_min"] != "2024-06-01" {
+			t.Errorf("Expected first_shown_date_min '2024-06-01', got %v", filtering["first_shown_date_min"])
+		}
+		if filtering["first_shown_date_max"] != "2024-06-30" {
+			t.Errorf("Expected first_shown_date_max to be kept, got %v", filtering["first_shown_date_max"])
+		}
+
+		list := []map[string]interface{}{
+			{"ad_id": "ad_new_1", "first_shown_date": "2024-06-03"},
+			{"ad_id": "ad_old", "first_shown_date": "2024-05-28"},
+		}
+		if r.URL.Query().Get("page") == "2" {
+			list = []map[string]interface{}{
+				{"ad_id": "ad_new_2", "first_shown_date": "2024-06-01"},
+			}
+		}
+
+		response := map[string]interface{}{
+			"code":    0,
+			"message": "OK",
+			"data": map[string]interface{}{
+				"list": list,
+				"page_info": map[string]interface{}{
+					"page":         1,
+					"page_size":    100,
+					"total_number": 3,
+					"total_page":   2,
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test_access_token", server.URL, nil)
+	api := NewAPI(client)
+
+	oldMin := "2024-01-01"
+	dateMax := "2024-06-30"
+	req := &GetAdReportRequest{
+		SearchTerm: "test",
+		Filtering:  &AdReportFiltering{FirstShownDateMin: &oldMin, FirstShownDateMax: &dateMax},
+	}
+
+	ads, err := api.GetAdReportSince(context.Background(), req, "2024-06-01")
+	if err != nil {
+		t.Fatalf("GetAdReportSince failed: %v", err)
+	}
+
+	if callCount != 2 {
+		t.Errorf("Expected 2 API calls, got %d", callCount)
+	}
+
+	if len(ads) != 2 {
+		t.Fatalf("Expected 2 new ads, got %d", len(ads))
+	}
+	if ads[0].AdID != "ad_new_1" || ads[1].AdID != "ad_new_2" {
+		t.Errorf("Expected ads ad_new_1 and ad_new_2, got %s and %s", ads[0].AdID, ads[1].AdID)
+	}
+
+	if *req.Filtering.FirstShownDateMin != "2024-01-01" {
+		t.Errorf("Expected request filtering to be unchanged, got %s", *req.Filtering.FirstShownDateMin)
+	}
+}
+
+func TestGetAdReportSince_InvalidDate(t *testing.T) {
+	api := NewAPI(&tiktok.Client{})
+
+	_, err := api.GetAdReportSince(context.Background(), &GetAdReportRequest{SearchTerm: "test"}, "06/01/2024")
+	if err == nil {
+		t.Error("Expected error for invalid since date")
+	}
+}
@@ -0,0 +1,70 @@
+package tiktok
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// CodeRateLimited is the API error code returned when requests are made too frequently
+const CodeRateLimited int64 = 50001
+
+const (
+	defaultRateLimitRetries   = 3
+	defaultRateLimitBaseDelay = 2 * time.Second
+	maxRateLimitDelay         = time.Minute
+)
+
+// WithRateLimitRetry configures how rate-limited (50001) responses are retried.
+// The delay starts at baseDelay and doubles after every attempt, up to one minute.
+// A maxRetries of 0 disables retrying.
+func WithRateLimitRetry(maxRetries int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.rateLimitRetries = maxRetries
+		c.rateLimitBaseDelay = baseDelay
+	}
+}
+
+type idempotentKey struct{}
+
+// WithIdempotent returns a copy of ctx that marks the requests made with it as safe to repeat.
+// Rate-limited GETs are always retried; other methods are only retried when marked,
+// so a create or update is never sent twice unless the caller opts in.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// isIdempotent reports whether ctx was marked by WithIdempotent
+func isIdempotent(ctx context.Context) bool {
+	marked, _ := ctx.Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// retryable reports whether a rate-limited request with method may be retried
+func retryable(ctx context.Context, method string) bool {
+	return method == http.MethodGet || isIdempotent(ctx)
+}
+
+// rateLimitDelay returns the backoff before the given retry attempt (0-based),
+// capped at maxRateLimitDelay. The delay is doubled step by step so that a large
+// attempt cannot overflow.
+func (c *Client) rateLimitDelay(attempt int) time.Duration {
+	delay := c.rateLimitBaseDelay
+	for i := 0; i < attempt && delay < maxRateLimitDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRateLimitDelay)
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
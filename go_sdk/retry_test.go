@@ -0,0 +1,143 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rateLimitedServer returns 50001 for the first failures calls and succeeds afterwards
+func rateLimitedServer(t *testing.T, failures int, calls *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		*calls++
+		if *calls <= failures {
+			json.NewEncoder(w).Encode(Response{
+				Code:    ptrInt64(CodeRateLimited),
+				Message: ptrString("Too many requests"),
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(`{"result":"ok"}`),
+		})
+	}))
+}
+
+func TestClient_RateLimitRetry_Succeeds(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 2, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(3, time.Second))
+	var delays []time.Duration
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	resp, err := client.Post(WithIdempotent(context.Background()), "/test/path", nil, map[string]string{"key": "value"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), *resp.Code)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, delays)
+}
+
+func TestClient_RateLimitRetry_Get(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 1, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(3, time.Second))
+	client.sleep = func(context.Context, time.Duration) error { return nil }
+
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_RateLimitRetry_PostNotIdempotent(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 1, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(3, time.Second))
+	client.sleep = func(context.Context, time.Duration) error {
+		t.Error("unexpected backoff for a POST not marked idempotent")
+		return nil
+	}
+
+	_, err := client.Post(context.Background(), "/test/path", nil, map[string]string{"key": "value"})
+	require.Error(t, err)
+
+	errResp, ok := err.(*ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, CodeRateLimited, errResp.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_RateLimitRetry_Exhausted(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 10, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(2, time.Millisecond))
+	client.sleep = func(context.Context, time.Duration) error { return nil }
+
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.Error(t, err)
+
+	errResp, ok := err.(*ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, CodeRateLimited, errResp.Code)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_RateLimitRetry_Disabled(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 1, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(0, 0))
+
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_RateLimitRetry_ContextCanceled(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 10, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithRateLimitRetry(3, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Get(ctx, "/test/path", nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_RateLimitRetry_Defaults(t *testing.T) {
+	client := NewClient("test-token")
+	assert.Equal(t, defaultRateLimitRetries, client.rateLimitRetries)
+	assert.Equal(t, 2*time.Second, client.rateLimitDelay(0))
+	assert.Equal(t, 8*time.Second, client.rateLimitDelay(2))
+}
+
+func TestClient_RateLimitRetry_DelayCapped(t *testing.T) {
+	client := NewClient("test-token", WithRateLimitRetry(100, time.Second))
+	assert.Equal(t, 32*time.Second, client.rateLimitDelay(5))
+	assert.Equal(t, maxRateLimitDelay, client.rateLimitDelay(6))
+	assert.Equal(t, maxRateLimitDelay, client.rateLimitDelay(40))
+	assert.Equal(t, maxRateLimitDelay, client.rateLimitDelay(99))
+}
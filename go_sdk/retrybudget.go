@@ -0,0 +1,57 @@
+package tiktok
+
+import "sync"
+
+// retryBudget is a token bucket shared by all requests of a client that caps
+// the number of retries relative to the number of requests
+type retryBudget struct {
+	mu       sync.Mutex
+	ratio    float64
+	capacity float64
+	balance  float64
+}
+
+// WithRetryBudget caps rate-limit retries across the client to about ratio retries
+// per request (e.g. 0.1 for 10%), plus a reserve of burst retries.
+// When the budget is spent, requests fail fast instead of retrying.
+// A burst below 1 is raised to 1, since the budget could otherwise never
+// hold the single retry it takes to allow one.
+func WithRetryBudget(ratio float64, burst int) ClientOption {
+	if burst < 1 {
+		burst = 1
+	}
+	return func(c *Client) {
+		c.retryBudget = &retryBudget{
+			ratio:    ratio,
+			capacity: float64(burst),
+			balance:  float64(burst),
+		}
+	}
+}
+
+// deposit records a new request, earning ratio retries
+func (b *retryBudget) deposit() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.balance += b.ratio
+	if b.balance > b.capacity {
+		b.balance = b.capacity
+	}
+}
+
+// withdraw reports whether a retry is allowed and spends it
+func (b *retryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.balance < 1 {
+		return false
+	}
+	b.balance--
+	return true
+}
@@ -0,0 +1,95 @@
+package tiktok
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RetryBudget_StopsRetries(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 1000, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil,
+		WithRateLimitRetry(3, time.Millisecond),
+		WithRetryBudget(0.1, 5),
+	)
+	retries := 0
+	client.sleep = func(context.Context, time.Duration) error {
+		retries++
+		return nil
+	}
+
+	const requests = 50
+	for i := 0; i < requests; i++ {
+		_, err := client.Get(context.Background(), "/test/path", nil)
+		require.Error(t, err)
+	}
+
+	// At most the reserve of 5 plus 10% of the requests
+	assert.LessOrEqual(t, retries, 5+requests/10)
+	assert.GreaterOrEqual(t, retries, 5)
+	assert.Equal(t, requests+retries, calls)
+
+	// Once spent, a request fails fast after one attempt
+	before := calls
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.Error(t, err)
+	assert.Equal(t, before+1, calls)
+}
+
+func TestClient_RetryBudget_AllowsRetriesWithinBudget(t *testing.T) {
+	calls := 0
+	server := rateLimitedServer(t, 2, &calls)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil,
+		WithRateLimitRetry(3, time.Millisecond),
+		WithRetryBudget(0.1, 5),
+	)
+	client.sleep = func(context.Context, time.Duration) error { return nil }
+
+	_, err := client.Get(context.Background(), "/test/path", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryBudget_Refills(t *testing.T) {
+	b := &retryBudget{ratio: 0.5, capacity: 2, balance: 0}
+
+	assert.False(t, b.withdraw())
+	b.deposit()
+	assert.False(t, b.withdraw())
+	b.deposit()
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw())
+
+	for i := 0; i < 10; i++ {
+		b.deposit()
+	}
+	assert.True(t, b.withdraw())
+	assert.True(t, b.withdraw())
+	assert.False(t, b.withdraw(), "balance is capped at capacity")
+}
+
+func TestWithRetryBudget_ZeroBurstStillRetries(t *testing.T) {
+	client := NewClientWithConfig("test-token", "http://localhost", nil, WithRetryBudget(0.5, 0))
+	b := client.retryBudget
+	assert.Equal(t, 1.0, b.capacity)
+
+	assert.True(t, b.withdraw(), "the initial reserve allows one retry")
+	assert.False(t, b.withdraw())
+	b.deposit()
+	b.deposit()
+	assert.True(t, b.withdraw(), "deposits refill the budget")
+}
+
+func TestRetryBudget_NilIsUnlimited(t *testing.T) {
+	var b *retryBudget
+	b.deposit()
+	assert.True(t, b.withdraw())
+}
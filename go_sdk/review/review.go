@@ -0,0 +1,99 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+	"github.com/suthio/tiktok-business-api-sdk/go_sdk/ad"
+	"github.com/suthio/tiktok-business-api-sdk/go_sdk/adgroup"
+	"github.com/suthio/tiktok-business-api-sdk/go_sdk/campaign"
+)
+
+// API provides the review summary across campaigns, ad groups and ads
+type API struct {
+	client *tiktok.Client
+}
+
+// NewAPI creates a new review API instance
+func NewAPI(client *tiktok.Client) *API {
+	return &API{
+		client: client,
+	}
+}
+
+// Counts is the number of entities in each review state
+type Counts struct {
+	UnderReview int64 `json:"under_review"`
+	Rejected    int64 `json:"rejected"`
+	Delivering  int64 `json:"delivering"`
+}
+
+// Summary counts the campaigns, ad groups and ads of an advertiser by review state.
+// Campaigns are not reviewed, so only Campaigns.Delivering is set.
+type Summary struct {
+	Campaigns Counts `json:"campaigns"`
+	AdGroups  Counts `json:"adgroups"`
+	Ads       Counts `json:"ads"`
+}
+
+// GetReviewSummary counts the entities under review, rejected and delivering for an advertiser.
+// Each count is a single-item query that reads only the total from the page info,
+// so no entities are downloaded.
+func (a *API) GetReviewSummary(ctx context.Context, advertiserID string) (*Summary, error) {
+	adAPI := ad.NewAPI(a.client)
+	countAds := func(primary, secondary string) (int64, error) {
+		return adAPI.CountAds(ctx, advertiserID, &ad.Filtering{PrimaryStatus: optional(primary), SecondaryStatus: optional(secondary)})
+	}
+	adgroupAPI := adgroup.NewAPI(a.client)
+	countAdGroups := func(primary, secondary string) (int64, error) {
+		return adgroupAPI.CountAdGroups(ctx, advertiserID, &adgroup.Filtering{PrimaryStatus: optional(primary), SecondaryStatus: optional(secondary)})
+	}
+	campaignAPI := campaign.NewAPI(a.client)
+	countCampaigns := func(primary, secondary string) (int64, error) {
+		page, pageSize := int64(1), int64(1)
+		resp, err := campaignAPI.GetCampaigns(ctx, &campaign.GetCampaignRequest{
+			BaseListRequest: tiktok.BaseListRequest{Page: &page, PageSize: &pageSize},
+			AdvertiserID:    advertiserID,
+			Filtering:       &campaign.Filtering{PrimaryStatus: optional(primary), SecondaryStatus: optional(secondary)},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.PageInfo.TotalNumber, nil
+	}
+
+	var summary Summary
+	for _, q := range []struct {
+		count     func(primary, secondary string) (int64, error)
+		primary   string
+		secondary string
+		total     *int64
+	}{
+		{countAds, "", ad.SecondaryStatusAudit, &summary.Ads.UnderReview},
+		{countAds, "", ad.SecondaryStatusReaudit, &summary.Ads.UnderReview},
+		{countAds, "", ad.SecondaryStatusReject, &summary.Ads.Rejected},
+		{countAds, tiktok.PrimaryStatusDeliveryOK, "", &summary.Ads.Delivering},
+		{countAdGroups, "", adgroup.SecondaryStatusAudit, &summary.AdGroups.UnderReview},
+		{countAdGroups, "", adgroup.SecondaryStatusReaudit, &summary.AdGroups.UnderReview},
+		{countAdGroups, "", adgroup.SecondaryStatusReject, &summary.AdGroups.Rejected},
+		{countAdGroups, tiktok.PrimaryStatusDeliveryOK, "", &summary.AdGroups.Delivering},
+		{countCampaigns, tiktok.PrimaryStatusDeliveryOK, "", &summary.Campaigns.Delivering},
+	} {
+		n, err := q.count(q.primary, q.secondary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get review summary: %w", err)
+		}
+		*q.total += n
+	}
+
+	return &summary, nil
+}
+
+// optional returns a pointer to s, or nil if s is empty
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,64 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tiktok "github.com/suthio/tiktok-business-api-sdk/go_sdk"
+)
+
+func TestGetReviewSummary(t *testing.T) {
+	totals := map[string]int{
+		`/open_api/v1.3/ad/get/ {"secondary_status":"AD_STATUS_AUDIT"}`:                3,
+		`/open_api/v1.3/ad/get/ {"secondary_status":"AD_STATUS_REAUDIT"}`:              2,
+		`/open_api/v1.3/ad/get/ {"secondary_status":"AD_STATUS_AUDIT_DENY"}`:           4,
+		`/open_api/v1.3/ad/get/ {"primary_status":"STATUS_DELIVERY_OK"}`:               10,
+		`/open_api/v1.3/adgroup/get/ {"secondary_status":"ADGROUP_STATUS_AUDIT"}`:      1,
+		`/open_api/v1.3/adgroup/get/ {"secondary_status":"ADGROUP_STATUS_REAUDIT"}`:    0,
+		`/open_api/v1.3/adgroup/get/ {"secondary_status":"ADGROUP_STATUS_AUDIT_DENY"}`: 2,
+		`/open_api/v1.3/adgroup/get/ {"primary_status":"STATUS_DELIVERY_OK"}`:          5,
+		`/open_api/v1.3/campaign/get/ {"primary_status":"STATUS_DELIVERY_OK"}`:         3,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "123456", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, "1", r.URL.Query().Get("page_size"))
+
+		key := r.URL.Path + " " + r.URL.Query().Get("filtering")
+		total, ok := totals[key]
+		assert.True(t, ok, "unexpected query %s", key)
+
+		fmt.Fprintf(w, `{"code": 0, "message": "OK", "data": {"list": [], "page_info": {"page": 1, "page_size": 1, "total_number": %d, "total_page": %d}}}`, total, total)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	summary, err := api.GetReviewSummary(context.Background(), "123456")
+	require.NoError(t, err)
+
+	assert.Equal(t, Summary{
+		Campaigns: Counts{Delivering: 3},
+		AdGroups:  Counts{UnderReview: 1, Rejected: 2, Delivering: 5},
+		Ads:       Counts{UnderReview: 5, Rejected: 4, Delivering: 10},
+	}, *summary)
+}
+
+func TestGetReviewSummary_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code": 40001, "message": "no permission"}`)
+	}))
+	defer server.Close()
+
+	client := tiktok.NewClientWithConfig("test-token", server.URL, nil)
+	api := NewAPI(client)
+
+	_, err := api.GetReviewSummary(context.Background(), "123456")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get review summary")
+}
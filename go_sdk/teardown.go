@@ -0,0 +1,84 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// teardownBatchSize is the largest number of IDs sent in one status update
+const teardownBatchSize = 20
+
+// teardownSteps lists the entity types in deletion order: ads before their ad groups,
+// and ad groups before their campaigns
+var teardownSteps = []struct {
+	entityType string
+	path       string
+	idsField   string
+}{
+	{EntityTypeAd, "/open_api/v1.3/ad/status/update/", "ad_ids"},
+	{EntityTypeAdGroup, "/open_api/v1.3/adgroup/status/update/", "adgroup_ids"},
+	{EntityTypeCampaign, "/open_api/v1.3/campaign/status/update/", "campaign_ids"},
+}
+
+// Teardown deletes every entity recorded in m: ads first, then ad groups, then campaigns.
+// IDs are deleted in batches per advertiser by setting their operation status to DELETE.
+// A failed batch does not stop the teardown; all failures are returned joined together.
+func (c *Client) Teardown(ctx context.Context, m *Manifest) error {
+	entries := m.Entries()
+
+	var errs []error
+	for _, step := range teardownSteps {
+		for _, group := range groupByAdvertiser(entries, step.entityType) {
+			for start := 0; start < len(group.ids); start += teardownBatchSize {
+				if err := ctx.Err(); err != nil {
+					return errors.Join(append(errs, err)...)
+				}
+
+				end := start + teardownBatchSize
+				if end > len(group.ids) {
+					end = len(group.ids)
+				}
+				ids := group.ids[start:end]
+
+				body := map[string]interface{}{
+					"advertiser_id":    group.advertiserID,
+					step.idsField:      ids,
+					"operation_status": "DELETE",
+				}
+				var resp struct{}
+				if err := DoPost(ctx, c, step.path, body, &resp); err != nil {
+					errs = append(errs, fmt.Errorf("failed to delete %s %v: %w", step.entityType, ids, err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// advertiserIDs is the IDs of one entity type belonging to one advertiser
+type advertiserIDs struct {
+	advertiserID string
+	ids          []string
+}
+
+// groupByAdvertiser returns the IDs of entityType grouped by advertiser, in the order
+// the advertisers and IDs were recorded
+func groupByAdvertiser(entries []ManifestEntry, entityType string) []advertiserIDs {
+	var groups []advertiserIDs
+	index := make(map[string]int)
+	for _, e := range entries {
+		if e.Type != entityType {
+			continue
+		}
+		i, ok := index[e.AdvertiserID]
+		if !ok {
+			i = len(groups)
+			index[e.AdvertiserID] = i
+			groups = append(groups, advertiserIDs{advertiserID: e.AdvertiserID})
+		}
+		groups[i].ids = append(groups[i].ids, e.ID)
+	}
+	return groups
+}
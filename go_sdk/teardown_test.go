@@ -0,0 +1,97 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type teardownCall struct {
+	path string
+	body map[string]interface{}
+}
+
+func newTeardownServer(t *testing.T, failAdvertiser string) (*httptest.Server, *[]teardownCall) {
+	var mu sync.Mutex
+	calls := &[]teardownCall{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		*calls = append(*calls, teardownCall{path: r.URL.Path, body: body})
+		mu.Unlock()
+
+		if body["advertiser_id"] == failAdvertiser {
+			_, _ = w.Write([]byte(`{"code": 40002, "message": "permission denied", "request_id": "req-1"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code": 0, "message": "OK", "data": {}}`))
+	}))
+	return server, calls
+}
+
+func TestTeardown_DeletionOrder(t *testing.T) {
+	server, calls := newTeardownServer(t, "adv-2")
+	defer server.Close()
+
+	m := NewManifest()
+	m.Record(EntityTypeCampaign, "adv-1", "c-1")
+	m.Record(EntityTypeAdGroup, "adv-1", "ag-1")
+	m.Record(EntityTypeAd, "adv-1", "ad-1", "ad-2")
+	m.Record(EntityTypeAd, "adv-2", "ad-3")
+	m.Record(EntityTypeAdGroup, "adv-1", "ag-2")
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+	err := client.Teardown(context.Background(), m)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete ad [ad-3]")
+	assert.Contains(t, err.Error(), "permission denied")
+
+	require.Len(t, *calls, 4)
+	assert.Equal(t, "/open_api/v1.3/ad/status/update/", (*calls)[0].path)
+	assert.Equal(t, map[string]interface{}{
+		"advertiser_id":    "adv-1",
+		"ad_ids":           []interface{}{"ad-1", "ad-2"},
+		"operation_status": "DELETE",
+	}, (*calls)[0].body)
+	assert.Equal(t, "/open_api/v1.3/ad/status/update/", (*calls)[1].path)
+	assert.Equal(t, "adv-2", (*calls)[1].body["advertiser_id"])
+	assert.Equal(t, "/open_api/v1.3/adgroup/status/update/", (*calls)[2].path)
+	assert.Equal(t, []interface{}{"ag-1", "ag-2"}, (*calls)[2].body["adgroup_ids"])
+	assert.Equal(t, "/open_api/v1.3/campaign/status/update/", (*calls)[3].path)
+	assert.Equal(t, []interface{}{"c-1"}, (*calls)[3].body["campaign_ids"])
+}
+
+func TestTeardown_Batches(t *testing.T) {
+	server, calls := newTeardownServer(t, "")
+	defer server.Close()
+
+	m := NewManifest()
+	for i := 0; i < 25; i++ {
+		m.Record(EntityTypeAd, "adv-1", fmt.Sprintf("ad-%d", i))
+	}
+
+	client := NewClientWithConfig("test-token", server.URL, nil)
+	require.NoError(t, client.Teardown(context.Background(), m))
+
+	require.Len(t, *calls, 2)
+	assert.Len(t, (*calls)[0].body["ad_ids"], 20)
+	assert.Len(t, (*calls)[1].body["ad_ids"], 5)
+}
+
+func TestTeardown_Empty(t *testing.T) {
+	client := NewClientWithConfig("test-token", "http://127.0.0.1:0", nil)
+
+	assert.NoError(t, client.Teardown(context.Background(), NewManifest()))
+	assert.NoError(t, client.Teardown(context.Background(), nil))
+}
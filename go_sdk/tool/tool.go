@@ -35,7 +35,7 @@ type Carrier struct {
 // Reference: https://business-api.tiktok.com/portal/docs?id=1737168013095938
 func (a *API) GetCarrier(ctx context.Context, advertiserID string) (*CarrierResponse, error) {
 	params := url.Values{}
-	params.Set("advertiser_id", advertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
 
 	// Use generic DoGet helper
 	var resp CarrierResponse
@@ -61,7 +61,7 @@ type Language struct {
 // Reference: https://business-api.tiktok.com/portal/docs?id=1737188554152962
 func (a *API) GetLanguage(ctx context.Context, advertiserID string) (*LanguageResponse, error) {
 	params := url.Values{}
-	params.Set("advertiser_id", advertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
 
 	// Use generic DoGet helper
 	var resp LanguageResponse
@@ -87,13 +87,9 @@ type ActionCategory struct {
 // Reference: https://business-api.tiktok.com/portal/docs?id=1737166752522241
 func (a *API) GetActionCategory(ctx context.Context, advertiserID string, specialIndustries []string) (*ActionCategoryResponse, error) {
 	params := url.Values{}
-	params.Set("advertiser_id", advertiserID)
+	params.Set("advertiser_id", tiktok.ResolveAdvertiserID(ctx, advertiserID))
 
-	if len(specialIndustries) > 0 {
-		for _, industry := range specialIndustries {
-			params.Add("special_industries", industry)
-		}
-	}
+	tiktok.AddRepeatedParam(params, "special_industries", specialIndustries)
 
 	// Use generic DoGet helper
 	var resp ActionCategoryResponse
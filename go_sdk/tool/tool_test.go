@@ -88,6 +88,7 @@ func TestGetActionCategory_Success(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Contains(t, r.URL.Path, "/open_api/v1.3/tool/action_category/")
 		assert.Equal(t, "test-advertiser-id", r.URL.Query().Get("advertiser_id"))
+		assert.Equal(t, []string{"HOUSING"}, r.URL.Query()["special_industries"])
 
 		actionCategoryData := ActionCategoryResponse{
 			ActionCategories: []ActionCategory{
@@ -0,0 +1,42 @@
+package tiktok
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// Default transport tuning for many small requests against a single API host
+const (
+	defaultTimeout             = 30 * time.Second
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 32
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// NewDefaultHTTPClient returns the HTTP client used when none is supplied.
+// Its transport keeps more idle connections per host than Go's default of 2,
+// so concurrent batch calls reuse connections instead of redialing.
+func NewDefaultHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   defaultTimeout,
+		Transport: newDefaultTransport(),
+	}
+}
+
+// newDefaultTransport returns an http.Transport tuned for connection reuse
+func newDefaultTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          defaultMaxIdleConns,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
@@ -0,0 +1,45 @@
+package tiktok
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDefaultHTTPClient(t *testing.T) {
+	client := NewDefaultHTTPClient()
+
+	assert.Equal(t, 30*time.Second, client.Timeout)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 100, transport.MaxIdleConns)
+	assert.Equal(t, 32, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 10*time.Second, transport.TLSHandshakeTimeout)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.NotNil(t, transport.Proxy)
+}
+
+func TestNewClient_UsesTunedTransport(t *testing.T) {
+	for name, client := range map[string]*Client{
+		"NewClient":           NewClient("test-token"),
+		"NewClientWithConfig": NewClientWithConfig("test-token", "", nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			transport, ok := client.httpClient.Transport.(*http.Transport)
+			require.True(t, ok)
+			assert.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		})
+	}
+}
+
+func TestNewClientWithConfig_KeepsCustomTransport(t *testing.T) {
+	custom := &http.Client{Transport: http.DefaultTransport}
+	client := NewClientWithConfig("test-token", "", custom)
+
+	assert.Same(t, custom, client.httpClient)
+}
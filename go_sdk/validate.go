@@ -0,0 +1,126 @@
+package tiktok
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+)
+
+// Logger is the logging interface used by the client. *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// ValidationMode controls how responses missing expected fields are handled
+type ValidationMode int
+
+const (
+	// ValidationOff skips response validation (the default)
+	ValidationOff ValidationMode = iota
+	// ValidationWarn logs responses missing expected fields
+	ValidationWarn
+	// ValidationStrict fails requests whose responses miss expected fields
+	ValidationStrict
+)
+
+// requiredResponseFields lists response fields that must be present whenever
+// the result type declares them, e.g. page_info on list endpoints
+var requiredResponseFields = map[string]bool{
+	"page_info": true,
+}
+
+// WithLogger sets the logger used for client diagnostics.
+// Without one, the standard library's default logger is used.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithResponseValidation enables checking responses for expected fields to catch API drift
+func WithResponseValidation(mode ValidationMode) ClientOption {
+	return func(c *Client) {
+		c.validation = mode
+	}
+}
+
+// ResponseValidationError is returned in ValidationStrict mode when a response is missing expected fields
+type ResponseValidationError struct {
+	Path    string
+	Missing []string
+}
+
+// Error implements the error interface
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("response from %s is missing required fields: %s", e.Path, strings.Join(e.Missing, ", "))
+}
+
+// validateResponse checks data against the required fields declared by result's type
+func (c *Client) validateResponse(path string, data json.RawMessage, result interface{}) error {
+	if c.validation == ValidationOff {
+		return nil
+	}
+
+	required := requiredFields(reflect.TypeOf(result))
+	if len(required) == 0 {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// Not a JSON object, nothing to check
+		return nil
+	}
+
+	var missing []string
+	for _, name := range required {
+		if raw, ok := fields[name]; !ok || string(raw) == "null" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	err := &ResponseValidationError{Path: path, Missing: missing}
+	if c.validation == ValidationStrict {
+		return err
+	}
+	c.logf("tiktok: %v", err)
+	return nil
+}
+
+// logf writes to the configured logger, or the standard logger if none is set
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
+
+// requiredFields returns the json names of required fields declared on t
+func requiredFields(t reflect.Type) []string {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.Anonymous && name == "" {
+			names = append(names, requiredFields(f.Type)...)
+			continue
+		}
+		if requiredResponseFields[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
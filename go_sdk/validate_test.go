@@ -0,0 +1,103 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+type listResult struct {
+	List     []map[string]interface{} `json:"list"`
+	PageInfo PageInfo                 `json:"page_info"`
+}
+
+func newValidationServer(t *testing.T, data string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := Response{
+			Code: ptrInt64(0),
+			Data: json.RawMessage(data),
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestResponseValidation_MissingPageInfo(t *testing.T) {
+	server := newValidationServer(t, `{"list":[{"id":"1"}]}`)
+	defer server.Close()
+
+	t.Run("strict returns error", func(t *testing.T) {
+		client := NewClientWithConfig("test-token", server.URL, nil, WithResponseValidation(ValidationStrict))
+
+		var result listResult
+		err := DoGet(context.Background(), client, "/test/list/", nil, &result)
+
+		var validationErr *ResponseValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "/test/list/", validationErr.Path)
+		assert.Equal(t, []string{"page_info"}, validationErr.Missing)
+	})
+
+	t.Run("warn logs and succeeds", func(t *testing.T) {
+		logger := &recordingLogger{}
+		client := NewClientWithConfig("test-token", server.URL, nil,
+			WithResponseValidation(ValidationWarn), WithLogger(logger))
+
+		var result listResult
+		err := DoGet(context.Background(), client, "/test/list/", nil, &result)
+
+		require.NoError(t, err)
+		assert.Len(t, result.List, 1)
+		require.Len(t, logger.lines, 1)
+		assert.Contains(t, logger.lines[0], "page_info")
+	})
+
+	t.Run("disabled ignores it", func(t *testing.T) {
+		logger := &recordingLogger{}
+		client := NewClientWithConfig("test-token", server.URL, nil, WithLogger(logger))
+
+		var result listResult
+		err := DoGet(context.Background(), client, "/test/list/", nil, &result)
+
+		require.NoError(t, err)
+		assert.Empty(t, logger.lines)
+	})
+}
+
+func TestResponseValidation_Passes(t *testing.T) {
+	server := newValidationServer(t, `{"list":[],"page_info":{"page":1,"total_page":1}}`)
+	defer server.Close()
+
+	client := NewClientWithConfig("test-token", server.URL, nil, WithResponseValidation(ValidationStrict))
+
+	var result listResult
+	require.NoError(t, DoGet(context.Background(), client, "/test/list/", nil, &result))
+
+	// Result types without page_info are not checked
+	var plain map[string]interface{}
+	require.NoError(t, DoGet(context.Background(), client, "/test/list/", nil, &plain))
+}
+
+func TestRequiredFields_Embedded(t *testing.T) {
+	type wrapped struct {
+		listResult
+		Extra string `json:"extra"`
+	}
+
+	assert.Equal(t, []string{"page_info"}, requiredFields(reflect.TypeOf(&wrapped{})))
+}